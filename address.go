@@ -0,0 +1,257 @@
+package inspectdata
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Regular Expressions for street addresses
+// Number first layouts of the US and UK ex: 123 N Main St Apt 4B, Springfield, IL 62704 or 10 Downing Street, London SW1A 2AA
+const reNumberFirstAddress = `(?i)^(?P<number>\d{1,6}[a-z]?(?:-\d{1,5})?)\s+` +
+	`(?P<streetname>(?P<street>(?:[\p{L}0-9.'-]+\s+){0,3}?[\p{L}0-9.'-]+?)` +
+	`(?:\s+(?P<suffix>` + usStreetSuffixes + `)\.?)?)` +
+	`(?:\s+(?P<direction>[NS][EW]?|[EW])\.?)?` +
+	`(?:,?\s+(?:(?:apt|apartment|suite|ste|unit|bldg|building|floor|fl|room|rm|flat)\.?\s*#?|#)\s*(?P<unit>[a-z0-9-]+))?` +
+	`(?:,?\s+(?P<city>[\p{L}.' -]+?),?\s+(?P<state>[a-z]{2})\.?(?:,?\s+(?P<zip>\d{5}(?:-\d{4})?))?` +
+	`|,\s*(?P<ukcity>[\p{L}.' -]+?),?\s+(?P<ukpostal>[a-z]{1,2}[0-9][a-z0-9]? ?[0-9][a-z]{2}))?$`
+
+// European layouts with the street type a prefix or compound suffix of the street name, and the postal code before the city
+// ex: Hauptstraße 5, 10115 Berlin or 12 Rue de Rivoli, 75001 Paris
+const reEuropeanAddress = `(?i)^(?:(?P<number>\d{1,5}[a-z]?)(?:\s*(?:bis|ter))?,?\s+)?` +
+	`(?P<street>[\p{L}.' -]+?)` +
+	`(?:,?\s+(?P<streetnumber>\d{1,5}[a-z]?(?:[-/]\d{1,5}[a-z]?)?))?` +
+	`(?:,\s*(?:[a-z]{1,2}-)?(?P<postal>\d{4}(?: ?[a-z]{2}|-\d{3})?|\d{5}(?:-\d{3})?|\d{2}-\d{3}|\d{3} \d{2})\s+(?P<city>[\p{L}.' -]+))?$`
+
+// US street suffixes per USPS Publication 28, spelled out and abbreviated
+const usStreetSuffixes = `street|st|avenue|ave|av|road|rd|boulevard|blvd|drive|dr|lane|ln|court|ct|place|pl|` +
+	`way|circle|cir|terrace|ter|parkway|pkwy|highway|hwy|square|sq|trail|trl|plaza|plz|alley|aly|loop|` +
+	`crescent|cres|close|gardens|grove|row|walk|broadway|pike|expressway|expy|freeway|fwy|route|rte`
+
+var validNumberFirstAddress = regexp.MustCompile(reNumberFirstAddress)
+var validEuropeanAddress = regexp.MustCompile(reEuropeanAddress)
+
+// Street type of European street names and the countries using it in order of precedence
+type streetType struct {
+	word      string   // Street type lowercase ex: straße
+	prefix    bool     // Street type leads the street name ex: rue, rather than a compound suffix ex: straße
+	countries []string // Country ISO ALPHA-2 Codes of addresses with the street type
+}
+
+var streetTypes = []streetType{
+	{"straße", false, []string{"DE", "AT", "CH"}},
+	{"strasse", false, []string{"DE", "CH", "AT"}},
+	{"str.", false, []string{"DE", "AT", "CH"}},
+	{"gasse", false, []string{"AT", "DE", "CH"}},
+	{"weg", false, []string{"DE", "NL", "AT", "CH"}},
+	{"platz", false, []string{"DE", "AT", "CH"}},
+	{"allee", false, []string{"DE", "AT"}},
+	{"ring", false, []string{"DE", "AT"}},
+	{"damm", false, []string{"DE"}},
+	{"straat", false, []string{"NL", "BE"}},
+	{"laan", false, []string{"NL", "BE"}},
+	{"gracht", false, []string{"NL"}},
+	{"plein", false, []string{"NL", "BE"}},
+	{"kade", false, []string{"NL"}},
+	{"gatan", false, []string{"SE"}},
+	{"vägen", false, []string{"SE"}},
+	{"vej", false, []string{"DK"}},
+	{"gade", false, []string{"DK"}},
+	{"veien", false, []string{"NO"}},
+	{"gata", false, []string{"NO"}},
+	{"rue", true, []string{"FR", "BE", "CH"}},
+	{"avenue", true, []string{"FR", "BE", "CH"}},
+	{"boulevard", true, []string{"FR", "BE"}},
+	{"place", true, []string{"FR", "BE", "CH"}},
+	{"chemin", true, []string{"FR", "CH"}},
+	{"impasse", true, []string{"FR"}},
+	{"quai", true, []string{"FR", "CH"}},
+	{"via", true, []string{"IT", "CH"}},
+	{"viale", true, []string{"IT"}},
+	{"piazza", true, []string{"IT"}},
+	{"corso", true, []string{"IT"}},
+	{"calle", true, []string{"ES", "MX"}},
+	{"avenida", true, []string{"ES", "PT", "MX", "BR"}},
+	{"plaza", true, []string{"ES", "MX"}},
+	{"paseo", true, []string{"ES", "MX"}},
+	{"carrer", true, []string{"ES"}},
+	{"rua", true, []string{"PT", "BR"}},
+	{"travessa", true, []string{"PT", "BR"}},
+	{"praça", true, []string{"PT", "BR"}},
+	{"ulica", true, []string{"PL"}},
+	{"ul.", true, []string{"PL"}},
+	{"aleja", true, []string{"PL"}},
+}
+
+// Field name hints indicating a street address, and hints of other addresses such as an email or IP address
+var addressHints = []string{"address", "streetaddress", "street", "addressline", "addressline1", "addr",
+	"adresse", "anschrift", "direccion", "indirizzo", "endereco", "morada"}
+var otherAddressHints = []string{"email", "mail", "ip", "mac", "wallet", "url", "web", "server", "host", "bitcoin",
+	"btc", "eth", "ethereum", "contract"}
+
+// Street address components
+type AddressInfo struct {
+	Number     string // House or building number ex: 123
+	Street     string // Street name with its type ex: N Main St or Hauptstraße
+	Unit       string // Apartment, suite or unit ex: 4B
+	City       string // City ex: Springfield
+	State      string // US state USPS abbreviation ex: IL
+	PostalCode string // Postal code ex: 62704
+	Country    string // Country ISO ALPHA-2 Code ex: US
+}
+
+// Inspects the string to determine if it is a street address: a US address with a street suffix or a city, state
+// and ZIP code, a UK address with a postcode, or a European address with a street type and house number.
+// Returns Unknown if the string is not such an address.
+func inspectAddress(v string) CanonicalType {
+	if parseAddress(v, false) != nil {
+		return StreetAddress
+	}
+	return Unknown
+}
+
+// Inspects the string using the field name of the options to determine if it is a street address, where a house
+// number and street name without a street suffix or city suffice ex: 123 Main
+// Returns Unknown if the options do not indicate a street address for the data.
+func inspectAddressContext(v string, opts Options) CanonicalType {
+	if hasFieldHint(opts.Field, addressHints...) && !hasFieldHint(opts.Field, otherAddressHints...) && parseAddress(v, true) != nil {
+		return StreetAddress
+	}
+	return Unknown
+}
+
+// Parses the components of the address written on one or more lines, or nil if the string is not an address.
+// The relaxed parsing of address context accepts a house number and street name alone.
+func parseAddress(v string, relaxed bool) *AddressInfo {
+	v = strings.Join(strings.FieldsFunc(v, func(r rune) bool { return r == '\n' || r == '\r' }), ", ")
+	v = strings.TrimSpace(strings.Replace(v, ",,", ",", -1))
+	if info := parseNumberFirstAddress(v, relaxed); info != nil {
+		return info
+	}
+	return parseEuropeanAddress(v)
+}
+
+// Parses a number first address of the US or UK layout.
+func parseNumberFirstAddress(v string, relaxed bool) *AddressInfo {
+	m := validNumberFirstAddress.FindStringSubmatch(v)
+	if m == nil {
+		return nil
+	}
+	group := func(name string) string {
+		return m[validNumberFirstAddress.SubexpIndex(name)]
+	}
+	street, suffix := group("street"), group("suffix")
+	if !strings.ContainsAny(strings.ToLower(street), "abcdefghijklmnopqrstuvwxyz") {
+		return nil
+	}
+	// street names are capitalized ex: Main or 5th, unlike ordinary text ex: 3 dogs on the road
+	for _, word := range strings.Fields(street) {
+		if r, _ := utf8.DecodeRuneInString(word); !relaxed && !unicode.IsUpper(r) && !unicode.IsDigit(r) {
+			return nil
+		}
+	}
+	info := &AddressInfo{Number: group("number"), Street: group("streetname"), Unit: group("unit")}
+	if direction := group("direction"); direction != "" {
+		info.Street += " " + direction
+	}
+
+	switch {
+	case group("ukpostal") != "":
+		postal := strings.ToUpper(group("ukpostal"))
+		if !validUKPostalCode.MatchString(postal) {
+			return nil
+		}
+		info.City, info.PostalCode, info.Country = group("ukcity"), postal, "GB"
+	case group("state") != "":
+		state := strings.ToUpper(group("state"))
+		if !isUSState(state) {
+			return nil
+		}
+		info.City, info.State, info.PostalCode, info.Country = group("city"), state, group("zip"), "US"
+		// the ZIP code is a strong signal of a US address without a street suffix
+		if info.PostalCode == "" && suffix == "" {
+			return nil
+		}
+		if p, ok := findPostalCode(info.PostalCode, Options{Country: "US"}); info.PostalCode != "" && (!ok || p.canonical != USPostalCode) {
+			return nil
+		}
+	case suffix == "" && !relaxed:
+		return nil
+	}
+	return info
+}
+
+// Parses a European address with a street type and house number, where the country is determined by the street type
+// and postal code.
+func parseEuropeanAddress(v string) *AddressInfo {
+	m := validEuropeanAddress.FindStringSubmatch(v)
+	if m == nil {
+		return nil
+	}
+	group := func(name string) string {
+		return m[validEuropeanAddress.SubexpIndex(name)]
+	}
+	street := strings.TrimSpace(group("street"))
+	number := group("number")
+	if number == "" {
+		number = group("streetnumber")
+	}
+	t, ok := findStreetType(street)
+	if !ok || number == "" || (group("number") != "" && !t.prefix) {
+		return nil
+	}
+
+	info := &AddressInfo{Number: number, Street: street, City: strings.TrimSpace(group("city")), PostalCode: strings.ToUpper(group("postal"))}
+	for _, country := range t.countries {
+		if info.PostalCode == "" {
+			info.Country = country
+			break
+		}
+		if _, ok := findPostalCode(info.PostalCode, Options{Country: country}); ok {
+			info.Country = country
+			break
+		}
+	}
+	if info.Country == "" {
+		return nil
+	}
+	return info
+}
+
+// Finds the street type of the European street name, as its leading word or the compound suffix of any of its words.
+func findStreetType(street string) (streetType, bool) {
+	words := strings.Fields(strings.ToLower(street))
+	if len(words) == 0 {
+		return streetType{}, false
+	}
+	for _, t := range streetTypes {
+		if t.prefix && words[0] == t.word && len(words) > 1 {
+			return t, true
+		}
+		if !t.prefix {
+			for _, word := range words {
+				if strings.HasSuffix(word, t.word) && len(word) > len(t.word) || word == t.word && len(words) > 1 {
+					return t, true
+				}
+			}
+		}
+	}
+	return streetType{}, false
+}
+
+// Determines if the USPS abbreviation is of a US state or DC.
+func isUSState(state string) bool {
+	for _, l := range licenseFormats {
+		if l.state == state {
+			return true
+		}
+	}
+	return false
+}
+
+// Parses the components of the street address, using the field name of the options to parse a house number and
+// street name alone, where the data has already been inspected as a street address.
+func addressInfo(v string, opts Options) *AddressInfo {
+	return parseAddress(v, hasFieldHint(opts.Field, addressHints...))
+}
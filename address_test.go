@@ -0,0 +1,69 @@
+package inspectdata
+
+import (
+	"testing"
+)
+
+func TestInspectAddress(t *testing.T) {
+	addresses := map[string]AddressInfo{
+		"123 Main St": {"123", "Main St", "", "", "", "", ""},
+		"123 N Main St Apt 4B, Springfield, IL 62704":       {"123", "N Main St", "4B", "Springfield", "IL", "62704", "US"},
+		"1600 Pennsylvania Avenue NW, Washington, DC 20500": {"1600", "Pennsylvania Avenue NW", "", "Washington", "DC", "20500", "US"},
+		"742 Evergreen Terrace\nSpringfield, OR 97403-1234": {"742", "Evergreen Terrace", "", "Springfield", "OR", "97403-1234", "US"},
+		"55 Water, Brooklyn, NY 11201":                      {"55", "Water", "", "Brooklyn", "NY", "11201", "US"},
+		"350 5th Ave #3400, New York, NY 10118":             {"350", "5th Ave", "3400", "New York", "NY", "10118", "US"},
+		"10 Downing Street, London SW1A 2AA":                {"10", "Downing Street", "", "London", "", "SW1A 2AA", "GB"},
+		"Hauptstraße 5, 10115 Berlin":                       {"5", "Hauptstraße", "", "Berlin", "", "10115", "DE"},
+		"Mariahilfer Straße 120, 1070 Wien":                 {"120", "Mariahilfer Straße", "", "Wien", "", "1070", "AT"},
+		"Kalverstraat 92, 1012 PH Amsterdam":                {"92", "Kalverstraat", "", "Amsterdam", "", "1012 PH", "NL"},
+		"12 Rue de Rivoli, 75001 Paris":                     {"12", "Rue de Rivoli", "", "Paris", "", "75001", "FR"},
+		"Via Roma 10, 00184 Roma":                           {"10", "Via Roma", "", "Roma", "", "00184", "IT"},
+		"Calle de Alcalá, 50, 28014 Madrid":                 {"50", "Calle de Alcalá", "", "Madrid", "", "28014", "ES"},
+		"Rua Augusta 100, 1100-053 Lisboa":                  {"100", "Rua Augusta", "", "Lisboa", "", "1100-053", "PT"},
+		"Drottninggatan 53":                                 {"53", "Drottninggatan", "", "", "", "", "SE"},
+	}
+	for v, expected := range addresses {
+		datum, err := Inspect(v)
+		if err != nil {
+			t.Error(err)
+		}
+		if datum.Canonical != StreetAddress || datum.Address == nil || *datum.Address != expected || !datum.IsPII || datum.Country != expected.Country {
+			t.Errorf("Inspect should have detected PII StreetAddress %+v for %q, but got: %v %+v", expected, v, datum.Canonical, datum.Address)
+		}
+	}
+
+	// ordinary text, unknown state, ZIP code of the wrong format, no street type or house number
+	invalid := []string{
+		"3 dogs on the road",
+		"55 Water, Brooklyn, ZZ 11201",
+		"55 Water, Brooklyn, NY 1120",
+		"123 Main",
+		"Hauptbahnhof 5, 10115 Berlin",
+		"Rue de Rivoli",
+		"Kalverstraat 92, 10115 Amsterdam",
+	}
+	for _, v := range invalid {
+		if c, _ := inspectString(v); c == StreetAddress {
+			t.Errorf("inspectString should not have detected canonical type StreetAddress for %q", v)
+		}
+	}
+}
+
+func TestInspectAddressContext(t *testing.T) {
+	datum, _ := InspectWithOptions("123 Main", Options{Field: "address_line1"})
+	if datum.Canonical != StreetAddress || datum.Address == nil || datum.Address.Street != "Main" {
+		t.Errorf("InspectWithOptions should have detected canonical type StreetAddress, but got: %v %+v", datum.Canonical, datum.Address)
+	}
+	datum, _ = InspectWithOptions("123 Main", Options{Field: "email_address"})
+	if datum.Canonical == StreetAddress {
+		t.Errorf("InspectWithOptions should not have detected canonical type StreetAddress for an email address field")
+	}
+}
+
+func TestScanAddress(t *testing.T) {
+	text := "Please ship to 123 N Main St Apt 4B, Springfield, IL 62704 by Friday"
+	findings := Scan(text)
+	if len(findings) != 1 || findings[0].Canonical != StreetAddress || findings[0].Data != "123 N Main St Apt 4B, Springfield, IL 62704" {
+		t.Errorf("Scan should have found the street address, but got: %+v", findings)
+	}
+}
@@ -0,0 +1,163 @@
+package inspectdata
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// Regular Expressions for Asia-Pacific national identifiers
+const reIndianAadhaar = "^[2-9][0-9]{3} [0-9]{4} [0-9]{4}$"
+const reIndianAadhaarBare = "^[2-9][0-9]{11}$"
+const reIndianPAN = "^[A-Z]{3}[ABCFGHJLPT][A-Z][0-9]{4}[A-Z]$"
+const reSingaporeNRIC = "^[STFGM][0-9]{7}[A-Z]$"
+const reJapanMyNumber = "^[0-9]{4} ?[0-9]{4} ?[0-9]{4}$"
+const reKoreanRRN = "^[0-9]{6}-[1-8][0-9]{6}$"
+const reKoreanRRNBare = "^[0-9]{6}[1-8][0-9]{6}$"
+const reChineseRIC = "^[1-9][0-9]{5}(18|19|20)[0-9]{2}(0[1-9]|1[0-2])(0[1-9]|[12][0-9]|3[01])[0-9]{3}[0-9X]$"
+const reAustralianTFN = "^[0-9]{3} ?[0-9]{3} ?[0-9]{2,3}$"
+const reAustralianMedicare = "^[2-6][0-9]{3} [0-9]{5} [0-9]( ?[1-9])?$"
+const reAustralianMedicareBare = "^[2-6][0-9]{9}[1-9]?$"
+const reNewZealandIRD = "^[0-9]{2,3}-?[0-9]{3}-?[0-9]{3}$"
+
+// Singapore NRIC/FIN check letters indexed by weighted sum mod 11 per series
+const singaporeSTLetters = "JZIHGFEDCBA"
+const singaporeFGLetters = "XWUTRQPNMLK"
+const singaporeMLetters = "XWUTRQPNJLK"
+
+// Asia-Pacific national identifiers flagged PII, Medicare number additionally flagged PHI
+var apacIdentifiers = []identifier{
+	{IndianAadhaar, "IN", regexp.MustCompile(reIndianAadhaar), regexp.MustCompile(reIndianAadhaarBare), []string{"aadhaar", "aadhar", "uid"}, isIndianAadhaar, false, false},
+	{IndianPAN, "IN", regexp.MustCompile(reIndianPAN), nil, []string{"pancard", "permanentaccountnumber"}, noCheckDigit, false, false},
+	{SingaporeNRIC, "SG", regexp.MustCompile(reSingaporeNRIC), nil, []string{"nric", "fin"}, isSingaporeNRIC, false, false},
+	{JapanMyNumber, "JP", nil, regexp.MustCompile(reJapanMyNumber), []string{"mynumber", "kojinbango"}, isJapanMyNumber, false, false},
+	{KoreanRRN, "KR", regexp.MustCompile(reKoreanRRN), regexp.MustCompile(reKoreanRRNBare), []string{"rrn", "jumin"}, isKoreanRRN, false, false},
+	{ChineseRIC, "CN", regexp.MustCompile(reChineseRIC), nil, []string{"shenfenzheng", "residentidentity"}, isChineseRIC, false, false},
+	{AustralianTFN, "AU", nil, regexp.MustCompile(reAustralianTFN), []string{"tfn", "taxfilenumber"}, isAustralianTFN, false, false},
+	{AustralianMedicare, "AU", regexp.MustCompile(reAustralianMedicare), regexp.MustCompile(reAustralianMedicareBare), []string{"medicare"}, isAustralianMedicare, true, false},
+	{NewZealandIRD, "NZ", nil, regexp.MustCompile(reNewZealandIRD), []string{"ird", "irdnumber"}, isNewZealandIRD, false, false},
+}
+
+// Validates the Verhoeff check digit of an India Aadhaar number.
+func isIndianAadhaar(v string) bool {
+	return verhoeff(removeSeparators(v, " "))
+}
+
+// Validates the check letter of a Singapore NRIC (S, T) or FIN (F, G, M): the 7 digits weighted
+// 2, 7, 6, 5, 4, 3, 2 plus an offset of 4 for the T and G series and 3 for the M series, mod 11.
+func isSingaporeNRIC(v string) bool {
+	weights := [7]int{2, 7, 6, 5, 4, 3, 2}
+	sum := 0
+	for i, w := range weights {
+		sum += int(v[i+1]-'0') * w
+	}
+	letters := singaporeSTLetters
+	switch v[0] {
+	case 'T':
+		sum += 4
+	case 'F':
+		letters = singaporeFGLetters
+	case 'G':
+		sum += 4
+		letters = singaporeFGLetters
+	case 'M':
+		sum += 3
+		letters = singaporeMLetters
+	}
+	return letters[sum%11] == v[8]
+}
+
+// Validates the check digit of a Japan My Number (個人番号): the first 11 digits weighted from the
+// rightmost 2 to 7 then 2 to 6, where the check digit is 11 minus the sum mod 11 with remainders below 2 as 0.
+func isJapanMyNumber(v string) bool {
+	v = removeSeparators(v, " ")
+	sum := 0
+	for n := 1; n <= 11; n++ {
+		weight := n + 1
+		if n > 6 {
+			weight = n - 5
+		}
+		sum += int(v[11-n]-'0') * weight
+	}
+	check := 0
+	if sum%11 > 1 {
+		check = 11 - sum%11
+	}
+	return check == int(v[11]-'0')
+}
+
+// Validates a South Korea RRN (resident registration number) YYMMDD-SBBBBNC birth date and its
+// check digit: the first 12 digits weighted 2 to 9 then 2 to 5, where the check digit is 11 minus the sum mod 11, mod 10.
+func isKoreanRRN(v string) bool {
+	v = removeSeparators(v, "-")
+	month, _ := strconv.Atoi(v[2:4])
+	day, _ := strconv.Atoi(v[4:6])
+	if month < 1 || month > 12 || day < 1 || day > 31 {
+		return false
+	}
+	weights := [12]int{2, 3, 4, 5, 6, 7, 8, 9, 2, 3, 4, 5}
+	sum := 0
+	for i, w := range weights {
+		sum += int(v[i]-'0') * w
+	}
+	return (11-sum%11)%10 == int(v[12]-'0')
+}
+
+// Validates the ISO 7064 MOD 11-2 check character of a China Resident Identity Card number.
+func isChineseRIC(v string) bool {
+	return iso7064Mod112(v[:17]) == v[17]
+}
+
+// Validates an Australia TFN (tax file number) of 8 or 9 digits: the digits weighted
+// 10, 7, 8, 4, 6, 3, 5, 1 or 1, 4, 3, 7, 5, 8, 6, 9, 10 respectively must sum to a multiple of 11.
+func isAustralianTFN(v string) bool {
+	v = removeSeparators(v, " ")
+	weights := []int{1, 4, 3, 7, 5, 8, 6, 9, 10}
+	if len(v) == 8 {
+		weights = []int{10, 7, 8, 4, 6, 3, 5, 1}
+	}
+	sum := 0
+	for i, w := range weights {
+		sum += int(v[i]-'0') * w
+	}
+	return sum%11 == 0
+}
+
+// Validates the check digit of an Australia Medicare number: the first 8 digits weighted
+// 1, 3, 7, 9 repeating, mod 10, followed by the card issue number.
+func isAustralianMedicare(v string) bool {
+	v = removeSeparators(v, " ")
+	weights := [8]int{1, 3, 7, 9, 1, 3, 7, 9}
+	sum := 0
+	for i, w := range weights {
+		sum += int(v[i]-'0') * w
+	}
+	return sum%10 == int(v[8]-'0')
+}
+
+// Validates a New Zealand IRD number between 10-000-000 and 150-000-000: the 8 digit base weighted
+// 3, 2, 7, 6, 5, 4, 3, 2 where the check digit is 11 minus the sum mod 11, retrying with the
+// secondary weights 7, 4, 3, 2, 5, 2, 7, 6 when the check digit calculates as 10.
+func isNewZealandIRD(v string) bool {
+	v = removeSeparators(v, "-")
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 10000000 || n > 150000000 {
+		return false
+	}
+	if len(v) == 8 {
+		v = "0" + v
+	}
+	for _, weights := range [][8]int{{3, 2, 7, 6, 5, 4, 3, 2}, {7, 4, 3, 2, 5, 2, 7, 6}} {
+		sum := 0
+		for i, w := range weights {
+			sum += int(v[i]-'0') * w
+		}
+		check := 0
+		if sum%11 != 0 {
+			check = 11 - sum%11
+		}
+		if check != 10 {
+			return check == int(v[8]-'0')
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package inspectdata
+
+import (
+	"testing"
+)
+
+func TestInspectAPACIdentifier(t *testing.T) {
+	ids := map[string]CanonicalType{
+		"2341 2341 2346":     IndianAadhaar,
+		"ABCPE1234F":         IndianPAN,
+		"S1234567D":          SingaporeNRIC,
+		"T1234567J":          SingaporeNRIC,
+		"F1234567N":          SingaporeNRIC,
+		"G1234567X":          SingaporeNRIC,
+		"M1234567K":          SingaporeNRIC,
+		"900101-1234568":     KoreanRRN,
+		"11010519491231002X": ChineseRIC,
+		"440308198001010012": ChineseRIC,
+		"2950 39420 1":       AustralianMedicare,
+	}
+	for v, expected := range ids {
+		if c, _ := inspectString(v); c != expected {
+			t.Errorf("inspectString should have detected canonical type %v for %s, but got: %v", expected, v, c)
+		}
+	}
+
+	// invalid check digits, letters or dates
+	invalid := map[string]CanonicalType{
+		"2341 2341 2347":     IndianAadhaar,
+		"S1234567A":          SingaporeNRIC,
+		"900101-1234567":     KoreanRRN,
+		"901301-1234568":     KoreanRRN,
+		"11010519491231002Y": ChineseRIC,
+		"110105194912310021": ChineseRIC,
+		"2950 39421 1":       AustralianMedicare,
+	}
+	for v, unexpected := range invalid {
+		if c, _ := inspectString(v); c == unexpected {
+			t.Errorf("inspectString should not have detected canonical type %v for %s", unexpected, v)
+		}
+	}
+}
+
+func TestInspectAPACIdentifierContext(t *testing.T) {
+	contexts := []struct {
+		data     string
+		opts     Options
+		expected CanonicalType
+	}{
+		{"234123412346", Options{Country: "IN"}, IndianAadhaar},
+		{"234123412346", Options{Field: "aadhaar_no"}, IndianAadhaar},
+		{"1234 5678 9018", Options{Country: "JP"}, JapanMyNumber},
+		{"123456789018", Options{Field: "myNumber"}, JapanMyNumber},
+		{"9001011234568", Options{Country: "KR"}, KoreanRRN},
+		{"123 456 782", Options{Country: "AU"}, AustralianTFN},
+		{"37236580", Options{Field: "tfn"}, AustralianTFN},
+		{"29503942011", Options{Field: "medicare_number"}, AustralianMedicare},
+		{"49-091-850", Options{Country: "NZ"}, NewZealandIRD},
+		{"136410132", Options{Field: "ird"}, NewZealandIRD},
+	}
+	for _, ctx := range contexts {
+		datum, err := InspectWithOptions(ctx.data, ctx.opts)
+		if err != nil {
+			t.Error(err)
+		}
+		if datum.Canonical != ctx.expected || !datum.IsPII {
+			t.Errorf("InspectWithOptions should have detected PII %v for %s with %+v, but got: %+v", ctx.expected, ctx.data, ctx.opts, datum)
+		}
+	}
+
+	datum, _ := InspectWithOptions("2950 39420 1", Options{})
+	if !datum.IsPHI || datum.Country != "AU" {
+		t.Errorf("InspectWithOptions should have flagged AU Medicare number PHI, but got: %+v", datum)
+	}
+
+	// check digits invalid or outside the allocated range
+	if datum, _ := InspectWithOptions("123456789019", Options{Country: "JP"}); datum.Canonical == JapanMyNumber {
+		t.Errorf("InspectWithOptions should not have detected JapanMyNumber for invalid check digit")
+	}
+	if datum, _ := InspectWithOptions("9125568", Options{Country: "NZ"}); datum.Canonical == NewZealandIRD {
+		t.Errorf("InspectWithOptions should not have detected NewZealandIRD below range")
+	}
+}
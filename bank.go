@@ -0,0 +1,56 @@
+package inspectdata
+
+import (
+	"regexp"
+)
+
+// Regular Expressions for US bank data
+const reABARouting = "^[0-9]{9}$"
+const reBankAccount = "^[0-9]{4,17}$" // ACH account numbers are up to 17 digits
+
+var validABARouting = regexp.MustCompile(reABARouting)
+var validBankAccount = regexp.MustCompile(reBankAccount)
+
+// Field name hints indicating bank data
+var routingHints = []string{"aba", "rtn", "routing", "routingnumber", "transitnumber"}
+var bankAccountHints = []string{"acct", "acctno", "accountnumber", "accountno", "accountnum", "accountnbr", "bankaccount", "dda"}
+
+// Inspects the string to determine if it is an ABA routing transit number.
+// The first two digits must be a valid Federal Reserve routing symbol prefix:
+//  00     US Government
+//  01-12  Federal Reserve district primary
+//  21-32  Federal Reserve district thrift
+//  61-72  Federal Reserve district electronic
+//  80     Traveler's checks
+// and the 3-7-1 weighted sum of the digits must be a multiple of 10.
+func isABARouting(v string) bool {
+	if !validABARouting.MatchString(v) {
+		return false
+	}
+
+	prefix := int(v[0]-'0')*10 + int(v[1]-'0')
+	switch {
+	case prefix <= 12, prefix >= 21 && prefix <= 32, prefix >= 61 && prefix <= 72, prefix == 80:
+	default:
+		return false
+	}
+
+	weights := [3]int{3, 7, 1}
+	sum := 0
+	for i := 0; i < len(v); i++ {
+		sum += int(v[i]-'0') * weights[i%3]
+	}
+	return sum%10 == 0
+}
+
+// Inspects the string using field context to determine if it is a bank routing or account number.
+// Returns Unknown if the field does not indicate bank data or the data does not match.
+func inspectBankContext(v string, opts Options) CanonicalType {
+	if hasFieldHint(opts.Field, routingHints...) && isABARouting(v) {
+		return ABARouting
+	}
+	if hasFieldHint(opts.Field, bankAccountHints...) && validBankAccount.MatchString(v) {
+		return BankAccount
+	}
+	return Unknown
+}
@@ -0,0 +1,76 @@
+package inspectdata
+
+import (
+	"testing"
+)
+
+func TestIsABARouting(t *testing.T) {
+	valid := []string{"021000021", "011000015", "121000358", "322271627"}
+	for _, v := range valid {
+		if !isABARouting(v) {
+			t.Errorf("isABARouting should have validated %s", v)
+		}
+	}
+
+	// invalid checksum, invalid Federal Reserve prefix (42) or wrong length
+	invalid := []string{"021000022", "421000029", "02100002", "0210000210", "02100002a"}
+	for _, v := range invalid {
+		if isABARouting(v) {
+			t.Errorf("isABARouting should not have validated %s", v)
+		}
+	}
+}
+
+func TestInspectABARouting(t *testing.T) {
+	c, _ := inspectString("021000021")
+	if c != ABARouting {
+		t.Errorf("inspectString should have detected canonical type ABARouting, but got: %v", c)
+	}
+	c, _ = inspectString("867530911")
+	if c != SSN {
+		t.Errorf("inspectString should have detected canonical type SSN, but got: %v", c)
+	}
+
+	datum, err := Inspect("021000021")
+	if err != nil {
+		t.Error(err)
+	}
+	if !datum.IsPII || !datum.IsFinancial {
+		t.Errorf("ABA routing number should be denoted as PII and financial")
+	}
+
+	datum, _ = InspectWithOptions("021000022", Options{Field: "routing_number"})
+	if datum.Canonical == ABARouting {
+		t.Errorf("InspectWithOptions should not have detected ABARouting with invalid checksum")
+	}
+}
+
+func TestInspectBankAccount(t *testing.T) {
+	fields := []string{"account_number", "acct", "BankAccount", "dda"}
+	for _, field := range fields {
+		datum, _ := InspectWithOptions("000123456789", Options{Field: field})
+		if datum.Canonical != BankAccount {
+			t.Errorf("InspectWithOptions should have detected BankAccount for field %s, but got: %v", field, datum.Canonical)
+		}
+		if !datum.IsPII || !datum.IsFinancial {
+			t.Errorf("Bank account number should be denoted as PII and financial")
+		}
+	}
+
+	datum, _ := InspectWithOptions("000123456789", Options{Field: "order_id"})
+	if datum.Canonical == BankAccount {
+		t.Errorf("InspectWithOptions should not have detected BankAccount without bank field context")
+	}
+	datum, _ = InspectWithOptions("123456789012345678", Options{Field: "account_number"})
+	if datum.Canonical == BankAccount {
+		t.Errorf("InspectWithOptions should not have detected BankAccount longer than 17 digits")
+	}
+
+	// a PAN in a bank account field remains card data
+	for _, v := range []string{"4111111111111111", "4111 1111 1111 1111"} {
+		datum, _ = InspectWithOptions(v, Options{Field: "account_number"})
+		if datum.Canonical != PANVisa || !datum.IsPCI {
+			t.Errorf("InspectWithOptions should have detected PCI PANVisa for %s in an account field, but got: %v", v, datum.Canonical)
+		}
+	}
+}
@@ -0,0 +1,312 @@
+package inspectdata
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Denotes the funding type of a payment card ex: credit, debit or prepaid
+type CardType string
+
+// Payment Card Types
+const (
+	CardCredit  CardType = "credit"  // Credit card
+	CardDebit   CardType = "debit"   // Debit card
+	CardPrepaid CardType = "prepaid" // Prepaid card
+)
+
+// Card details for an inspected Payment|Primary Card Number (PAN).
+// Issuer fields are only populated when the BIN is found in a file loaded via LoadBINFile or LoadBINs.
+type CardInfo struct {
+	PAN           string   // PAN normalized with any separators (spaces or dashes) removed
+	BIN           string   // Bank|Issuer Identification Number: leading 6 digits of the PAN
+	IssuerCountry string   // Issuer country ISO ALPHA-2 Code ex: US
+	Issuer        string   // Issuing bank or institution name
+	Type          CardType // Card funding type: credit, debit or prepaid
+	Truncated     bool     // Masked PAN displays at most the first 6 and last 4 digits per PCI DSS 3.4
+	Expiry        string   // Card expiration date MM/YY from card expiry or magnetic stripe track data
+}
+
+// Range of leading PAN digits (BIN/IIN) assigned to a card network.
+// The low and high prefixes are inclusive and always have the same number of digits.
+type binRange struct {
+	low, high string
+	network   CanonicalType
+	minLen    int // minimum PAN length for the range
+	maxLen    int // maximum PAN length for the range
+}
+
+// Minimum and maximum PAN lengths per ISO/IEC 7812
+const (
+	minPANLen = 13
+	maxPANLen = 19
+)
+
+// Built-in BIN/IIN ranges by card network.
+// When ranges overlap the range with the longest prefix wins, ex: Elo 438935 over Visa 4.
+var binRanges = []binRange{
+	{"34", "34", PANAmex, 15, 15},
+	{"37", "37", PANAmex, 15, 15},
+	{"4", "4", PANVisa, 13, 19},
+	{"51", "55", PANMC, 16, 16},
+	{"2221", "2720", PANMC, 16, 16},
+	{"6011", "6011", PANDiscover, 16, 19},
+	{"644", "649", PANDiscover, 16, 19},
+	{"65", "65", PANDiscover, 16, 19},
+	{"622126", "622925", PANDiscover, 16, 19},
+	{"300", "305", PANDiners, 14, 19},
+	{"3095", "3095", PANDiners, 14, 19},
+	{"36", "36", PANDiners, 14, 19},
+	{"38", "39", PANDiners, 14, 19},
+	{"3528", "3589", PANJCB, 16, 19},
+	{"2131", "2131", PANJCB, 15, 15},
+	{"1800", "1800", PANJCB, 15, 15},
+	{"62", "62", PANUnionPay, 16, 19},
+	{"81", "81", PANUnionPay, 16, 19},
+	{"5018", "5018", PANMaestro, 13, 19},
+	{"5020", "5020", PANMaestro, 13, 19},
+	{"5038", "5038", PANMaestro, 13, 19},
+	{"5893", "5893", PANMaestro, 13, 19},
+	{"6304", "6304", PANMaestro, 13, 19},
+	{"6759", "6759", PANMaestro, 13, 19},
+	{"6761", "6763", PANMaestro, 13, 19},
+	{"2200", "2204", PANMir, 16, 19},
+	{"508500", "508999", PANRuPay, 16, 16},
+	{"606985", "607984", PANRuPay, 16, 16},
+	{"608001", "608500", PANRuPay, 16, 16},
+	{"652150", "653149", PANRuPay, 16, 16},
+	{"401178", "401179", PANElo, 16, 16},
+	{"431274", "431274", PANElo, 16, 16},
+	{"438935", "438935", PANElo, 16, 16},
+	{"451416", "451416", PANElo, 16, 16},
+	{"457393", "457393", PANElo, 16, 16},
+	{"457631", "457632", PANElo, 16, 16},
+	{"504175", "504175", PANElo, 16, 16},
+	{"506699", "506778", PANElo, 16, 16},
+	{"509000", "509999", PANElo, 16, 16},
+	{"627780", "627780", PANElo, 16, 16},
+	{"636297", "636297", PANElo, 16, 16},
+	{"636368", "636368", PANElo, 16, 16},
+	{"650031", "650033", PANElo, 16, 16},
+	{"650035", "650051", PANElo, 16, 16},
+	{"650405", "650439", PANElo, 16, 16},
+	{"650485", "650538", PANElo, 16, 16},
+	{"650541", "650598", PANElo, 16, 16},
+	{"650700", "650718", PANElo, 16, 16},
+	{"650720", "650727", PANElo, 16, 16},
+	{"650901", "650978", PANElo, 16, 16},
+	{"651652", "651679", PANElo, 16, 16},
+	{"655000", "655019", PANElo, 16, 16},
+	{"655021", "655058", PANElo, 16, 16},
+	{"384100", "384100", PANHipercard, 16, 19},
+	{"384140", "384140", PANHipercard, 16, 19},
+	{"384160", "384160", PANHipercard, 16, 19},
+	{"606282", "606282", PANHipercard, 16, 19},
+	{"637095", "637095", PANHipercard, 16, 19},
+	{"637568", "637568", PANHipercard, 16, 19},
+	{"637599", "637599", PANHipercard, 16, 19},
+	{"637609", "637609", PANHipercard, 16, 19},
+	{"637612", "637612", PANHipercard, 16, 19},
+	{"506099", "506198", PANVerve, 16, 19},
+	{"507865", "507964", PANVerve, 16, 19},
+	{"650002", "650027", PANVerve, 16, 19},
+}
+
+// Card network names accepted in the network column of a BIN file
+var cardNetworks = map[string]CanonicalType{
+	"amex":       PANAmex,
+	"visa":       PANVisa,
+	"mastercard": PANMC,
+	"discover":   PANDiscover,
+	"diners":     PANDiners,
+	"jcb":        PANJCB,
+	"unionpay":   PANUnionPay,
+	"maestro":    PANMaestro,
+	"mir":        PANMir,
+	"rupay":      PANRuPay,
+	"elo":        PANElo,
+	"hipercard":  PANHipercard,
+	"verve":      PANVerve,
+}
+
+// BIN record loaded from a local BIN file
+type binRecord struct {
+	low, high string
+	network   CanonicalType
+	info      CardInfo
+}
+
+// BIN records loaded via LoadBINFile or LoadBINs guarded for concurrent inspection
+var (
+	binMutex   sync.RWMutex
+	binRecords []binRecord
+)
+
+// Determines if the prefix of the PAN falls within the inclusive range of low and high prefixes.
+func (r binRange) matches(pan string) bool {
+	return prefixInRange(pan, r.low, r.high)
+}
+
+// Compares the leading digits of the PAN against the inclusive prefix range.
+// Low and high must be digit strings of equal length, so comparing them as strings is numeric.
+func prefixInRange(pan, low, high string) bool {
+	if len(pan) < len(low) {
+		return false
+	}
+	prefix := pan[:len(low)]
+	return prefix >= low && prefix <= high
+}
+
+// Inspects the string to determine if it is a PAN for a known card network.
+// The PAN must be 13-19 digits, fall within a BIN range of the expected length and pass the Luhn check.
+// Returns Unknown if the string is not a valid PAN.
+func inspectPAN(v string) CanonicalType {
+	if len(v) < minPANLen || len(v) > maxPANLen || !isDigits(v) || !luhn(v) {
+		return Unknown
+	}
+
+	network := Unknown
+	longest := 0
+	for _, r := range binRanges {
+		if len(r.low) > longest && len(v) >= r.minLen && len(v) <= r.maxLen && r.matches(v) {
+			network = r.network
+			longest = len(r.low)
+		}
+	}
+	if network != Unknown {
+		return network
+	}
+
+	// fallback on network of locally loaded BIN records
+	if rec, ok := lookupBIN(v); ok {
+		return rec.network
+	}
+	return Unknown
+}
+
+// Finds the most specific (longest prefix) locally loaded BIN record for the PAN.
+func lookupBIN(pan string) (binRecord, bool) {
+	binMutex.RLock()
+	defer binMutex.RUnlock()
+
+	var found binRecord
+	ok := false
+	for _, rec := range binRecords {
+		if (!ok || len(rec.low) > len(found.low)) && prefixInRange(pan, rec.low, rec.high) {
+			found = rec
+			ok = true
+		}
+	}
+	return found, ok
+}
+
+// Generates the card details for the PAN enriched with any locally loaded BIN record.
+func cardInfo(pan string) *CardInfo {
+	info := &CardInfo{PAN: pan}
+	if len(pan) >= 6 {
+		info.BIN = pan[:6]
+	}
+	if rec, ok := lookupBIN(pan); ok {
+		info.IssuerCountry = rec.info.IssuerCountry
+		info.Issuer = rec.info.Issuer
+		info.Type = rec.info.Type
+	}
+	return info
+}
+
+// Loads card issuer details for BIN/IIN ranges from a local CSV file.
+// See LoadBINs for the expected file format.
+func LoadBINFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return LoadBINs(f)
+}
+
+// Loads card issuer details for BIN/IIN ranges from CSV records, replacing any previously loaded records.
+// Each record has the columns: bin, network, type, country, issuer where bin is a
+// prefix (ex: 411111) or inclusive range of equal length prefixes (ex: 411100-411199).
+// Network (ex: visa, mastercard, elo) and the remaining columns may be empty.
+// Lines starting with '#' and a header line starting with "bin" are ignored.
+//
+// Example File
+//  bin,network,type,country,issuer
+//  411111,visa,credit,US,Example Bank
+//  535300-535399,mastercard,debit,GB,Example Building Society
+func LoadBINs(r io.Reader) error {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var records []binRecord
+	for first := true; ; first = false {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if first && strings.EqualFold(strings.TrimSpace(fields[0]), "bin") {
+			continue
+		}
+		rec, err := parseBINRecord(fields)
+		if err != nil {
+			line, _ := reader.FieldPos(0)
+			return fmt.Errorf("Invalid BIN record on line %d: %v", line, err)
+		}
+		records = append(records, rec)
+	}
+
+	binMutex.Lock()
+	binRecords = records
+	binMutex.Unlock()
+	return nil
+}
+
+// Parses the CSV fields of a single BIN record.
+func parseBINRecord(fields []string) (binRecord, error) {
+	var rec binRecord
+	for len(fields) < 5 {
+		fields = append(fields, "")
+	}
+	for i := range fields {
+		fields[i] = strings.TrimSpace(fields[i])
+	}
+
+	rec.low, rec.high = fields[0], fields[0]
+	if i := strings.Index(fields[0], "-"); i >= 0 {
+		rec.low, rec.high = fields[0][:i], fields[0][i+1:]
+	}
+	if !isDigits(rec.low) || !isDigits(rec.high) || len(rec.low) != len(rec.high) || rec.low > rec.high {
+		return rec, errors.New("bin must be a digit prefix or range of equal length prefixes")
+	}
+
+	if fields[1] != "" {
+		network, ok := cardNetworks[strings.ToLower(fields[1])]
+		if !ok {
+			return rec, fmt.Errorf("unknown card network %s", fields[1])
+		}
+		rec.network = network
+	}
+
+	switch CardType(strings.ToLower(fields[2])) {
+	case CardCredit, CardDebit, CardPrepaid:
+		rec.info.Type = CardType(strings.ToLower(fields[2]))
+	case "":
+	default:
+		return rec, fmt.Errorf("unknown card type %s", fields[2])
+	}
+
+	rec.info.IssuerCountry = strings.ToUpper(fields[3])
+	rec.info.Issuer = fields[4]
+	return rec, nil
+}
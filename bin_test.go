@@ -0,0 +1,114 @@
+package inspectdata
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestInspectPAN(t *testing.T) {
+	cards := map[string]CanonicalType{
+		"371449635398431":  PANAmex,
+		"4111111111111111": PANVisa,
+		"4012888888881881": PANVisa,
+		"5500005555555559": PANMC,
+		"2221000000000009": PANMC,
+		"6011016011016011": PANDiscover,
+		"36438936438936":   PANDiners,
+		"3566003566003566": PANJCB,
+		"213100000000001":  PANJCB,
+		"180000000000002":  PANJCB,
+		"6200000000000005": PANUnionPay,
+		"6759649826438453": PANMaestro,
+		"5018000000000009": PANMaestro,
+		"2200000000000004": PANMir,
+		"6521500000000006": PANRuPay,
+		"6362970000457013": PANElo,
+		"4389350000000002": PANElo,
+		"6062825624254001": PANHipercard,
+		"5061000000000005": PANVerve,
+	}
+	for pan, expected := range cards {
+		if c := inspectPAN(pan); c != expected {
+			t.Errorf("inspectPAN should have detected %v for %s, but got: %v", expected, pan, c)
+		}
+	}
+
+	// failed Luhn check, invalid length or unassigned BIN
+	invalid := []string{"4111111111111112", "411111111111", "41111111111111111111", "9111111111111111", "4111-1111-1111-1111"}
+	for _, pan := range invalid {
+		if c := inspectPAN(pan); c != Unknown {
+			t.Errorf("inspectPAN should not have detected a PAN for %s, but got: %v", pan, c)
+		}
+	}
+}
+
+func TestInspectJCB15(t *testing.T) {
+	// 15 digit JCB PANs also pass the IMEI Luhn check
+	datum, err := Inspect("180000000000002")
+	if err != nil {
+		t.Error(err)
+	}
+	if datum.Canonical != PANJCB || !datum.IsPCI {
+		t.Errorf("Inspect should have detected PCI PANJCB, but got: %v", datum.Canonical)
+	}
+}
+
+func TestLoadBINs(t *testing.T) {
+	defer LoadBINs(strings.NewReader(""))
+
+	data := `bin,network,type,country,issuer
+# test issuers
+411111,visa,credit,us,Example Bank
+411100-411199,visa,debit,US,Example Bank Debit
+9111,mastercard,prepaid,GB,Example Prepaid
+`
+	if err := LoadBINs(strings.NewReader(data)); err != nil {
+		t.Fatalf("LoadBINs should have loaded BIN records, but got: %v", err)
+	}
+
+	info := cardInfo("4111111111111111")
+	if info.BIN != "411111" {
+		t.Errorf("cardInfo unexpected BIN %s", info.BIN)
+	}
+	if info.IssuerCountry != "US" || info.Issuer != "Example Bank" || info.Type != CardCredit {
+		t.Errorf("cardInfo should have used most specific BIN record, but got: %+v", info)
+	}
+	info = cardInfo("4111990000000000")
+	if info.Type != CardDebit {
+		t.Errorf("cardInfo should have matched BIN range record, but got: %+v", info)
+	}
+
+	// loaded network for BIN outside of built-in ranges
+	if c := inspectPAN("9111111111111111"); c != Unknown {
+		t.Errorf("inspectPAN should require Luhn check on loaded BIN, but got: %v", c)
+	}
+	if c := inspectPAN("9111000000000007"); c != PANMC {
+		t.Errorf("inspectPAN should have detected loaded BIN network PANMC, but got: %v", c)
+	}
+
+	datum, err := Inspect("4111111111111111")
+	if err != nil {
+		t.Error(err)
+	}
+	if datum.Card == nil || datum.Card.Issuer != "Example Bank" {
+		t.Errorf("Inspect should have enriched datum with card issuer, but got: %+v", datum.Card)
+	}
+
+	if err := LoadBINs(strings.NewReader("4111x,visa,,US,\n")); err == nil {
+		t.Errorf("LoadBINs should have errored on invalid bin")
+	}
+	if err := LoadBINs(strings.NewReader("411111,fakecard,,US,\n")); err == nil {
+		t.Errorf("LoadBINs should have errored on unknown network")
+	}
+	if err := LoadBINs(strings.NewReader("411111,visa,charge,US,\n")); err == nil {
+		t.Errorf("LoadBINs should have errored on unknown card type")
+	}
+	// the file line of the record is reported past comments and quoted multi-line fields
+	data = "bin,network,type,country,issuer\n# comment\n411111,visa,credit,US,\"Example\nBank\"\n4111x,visa,,US,\n"
+	if err := LoadBINs(strings.NewReader(data)); err == nil || !strings.Contains(err.Error(), "line 5") {
+		t.Errorf("LoadBINs should have errored on invalid bin of line 5, but got: %v", err)
+	}
+	if err := LoadBINFile("testdata/does-not-exist.csv"); err == nil {
+		t.Errorf("LoadBINFile should have errored on missing file")
+	}
+}
@@ -0,0 +1,32 @@
+package inspectdata
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Regular Expressions for Canadian identifiers and postal codes
+// Social Insurance numbers never start with 0 or 8, where 9 denotes a temporary resident.
+// Postal code letters exclude D, F, I, O, Q and U, with W and Z also excluded as the first letter.
+const reCanadianSIN = "^[1-79][0-9]{2}[ -][0-9]{3}[ -][0-9]{3}$"
+const reCanadianSINBare = "^[1-79][0-9]{8}$"
+const reCAPostalCode = "^[ABCEGHJ-NPRSTVXY][0-9][ABCEGHJ-NPRSTV-Z] ?[0-9][ABCEGHJ-NPRSTV-Z][0-9]$"
+
+var validCAPostalCode = regexp.MustCompile(reCAPostalCode)
+
+// Canadian identifiers flagged PII
+var caIdentifiers = []identifier{
+	{CanadianSIN, "CA", regexp.MustCompile(reCanadianSIN), regexp.MustCompile(reCanadianSINBare), []string{"sin", "socialinsurance"}, isCanadianSIN, false, false},
+}
+
+// Validates the Luhn check digit of a Canadian Social Insurance number, including temporary 9xx numbers.
+func isCanadianSIN(v string) bool {
+	return luhn(removeSeparators(v, " -"))
+}
+
+// Determines if the Canadian Social Insurance number is issued to a temporary resident, denoted by a leading 9.
+// Temporary numbers expire with the immigration document of their holder, unlike those of citizens and permanent
+// residents.
+func isTemporarySIN(v string) bool {
+	return strings.HasPrefix(v, "9")
+}
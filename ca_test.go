@@ -0,0 +1,65 @@
+package inspectdata
+
+import (
+	"testing"
+)
+
+func TestInspectCanadianSIN(t *testing.T) {
+	valid := []string{"130 692 544", "130-692-544", "912 345 675"}
+	for _, v := range valid {
+		if c, _ := inspectString(v); c != CanadianSIN {
+			t.Errorf("inspectString should have detected canonical type CanadianSIN for %s, but got: %v", v, c)
+		}
+	}
+
+	// invalid check digit, never issued 0 and 8 prefixes
+	invalid := []string{"130 692 545", "046 454 286", "812 345 676"}
+	for _, v := range invalid {
+		if c, _ := inspectString(v); c == CanadianSIN {
+			t.Errorf("inspectString should not have detected canonical type CanadianSIN for %s", v)
+		}
+	}
+
+	c, _ := inspectString("130692544")
+	if c != SSN {
+		t.Errorf("inspectString should have detected canonical type SSN for plain SIN without context, but got: %v", c)
+	}
+
+	datum, err := InspectWithOptions("130692544", Options{Country: "CA"})
+	if err != nil {
+		t.Error(err)
+	}
+	if datum.Canonical != CanadianSIN || !datum.IsPII || datum.Country != "CA" {
+		t.Errorf("InspectWithOptions should have detected PII CanadianSIN for CA, but got: %+v", datum)
+	}
+
+	if datum.Temporary {
+		t.Errorf("InspectWithOptions should not have denoted a regular CanadianSIN as temporary")
+	}
+
+	datum, _ = InspectWithOptions("912345675", Options{Field: "employee_sin"})
+	if datum.Canonical != CanadianSIN || !datum.Temporary {
+		t.Errorf("InspectWithOptions should have detected temporary CanadianSIN from field hint, but got: %+v", datum)
+	}
+	datum, _ = Inspect("912 345 675")
+	if datum.Canonical != CanadianSIN || !datum.Temporary || datum.Country != "CA" {
+		t.Errorf("Inspect should have detected temporary CanadianSIN, but got: %+v", datum)
+	}
+}
+
+func TestInspectCAPostalCode(t *testing.T) {
+	valid := []string{"K1A 0B1", "M5V 3L9", "H2X1Y4", "V6B 4Y8", "T2P 2M5"}
+	for _, v := range valid {
+		if c, _ := inspectString(v); c != CAPostalCode {
+			t.Errorf("inspectString should have detected canonical type CAPostalCode for %s, but got: %v", v, c)
+		}
+	}
+
+	// D, F, I, O, Q, U never used, W and Z never first
+	invalid := []string{"D1A 0B1", "W1A 0B1", "Z5V 3L9", "K1O 0B1", "K1A 0U1", "K1A 0B", "KK1 0B1"}
+	for _, v := range invalid {
+		if c, _ := inspectString(v); c == CAPostalCode {
+			t.Errorf("inspectString should not have detected canonical type CAPostalCode for %s", v)
+		}
+	}
+}
@@ -4,13 +4,125 @@ package inspectdata
 
 import "strconv"
 
-const _CanonicalType_name = "UnknownUUIDv4IPv4IPv6EmailCountryCode2CountryCode3LanguageCode2LanguageCode3USPostalCodeSSNUSDLatLongDateCCYYMMDDPANAmexPANVisaPANMCPANDiscoverPANDinersPANJCBSecret"
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[Unknown-0]
+	_ = x[UUIDv4-1]
+	_ = x[IPv4-2]
+	_ = x[IPv6-3]
+	_ = x[Email-4]
+	_ = x[CountryCode2-5]
+	_ = x[CountryCode3-6]
+	_ = x[LanguageCode2-7]
+	_ = x[LanguageCode3-8]
+	_ = x[USPostalCode-9]
+	_ = x[SSN-10]
+	_ = x[USD-11]
+	_ = x[LatLong-12]
+	_ = x[DateCCYYMMDD-13]
+	_ = x[PANAmex-14]
+	_ = x[PANVisa-15]
+	_ = x[PANMC-16]
+	_ = x[PANDiscover-17]
+	_ = x[PANDiners-18]
+	_ = x[PANJCB-19]
+	_ = x[Secret-20]
+	_ = x[PANUnionPay-21]
+	_ = x[PANMaestro-22]
+	_ = x[PANMir-23]
+	_ = x[PANRuPay-24]
+	_ = x[PANElo-25]
+	_ = x[PANHipercard-26]
+	_ = x[PANVerve-27]
+	_ = x[MaskedPAN-28]
+	_ = x[CardExpiry-29]
+	_ = x[CardCVV-30]
+	_ = x[Track1-31]
+	_ = x[Track2-32]
+	_ = x[ABARouting-33]
+	_ = x[BankAccount-34]
+	_ = x[BitcoinAddress-35]
+	_ = x[EthereumAddress-36]
+	_ = x[LitecoinAddress-37]
+	_ = x[MoneroAddress-38]
+	_ = x[SpanishDNI-39]
+	_ = x[SpanishNIE-40]
+	_ = x[ItalianFiscalCode-41]
+	_ = x[FrenchNIR-42]
+	_ = x[GermanTaxID-43]
+	_ = x[DutchBSN-44]
+	_ = x[BelgianNRN-45]
+	_ = x[PolishPESEL-46]
+	_ = x[SwedishPersonnummer-47]
+	_ = x[FinnishHETU-48]
+	_ = x[UKNINO-49]
+	_ = x[UKNHS-50]
+	_ = x[UKPostalCode-51]
+	_ = x[BrazilianCPF-52]
+	_ = x[BrazilianCNPJ-53]
+	_ = x[MexicanCURP-54]
+	_ = x[MexicanRFC-55]
+	_ = x[ArgentineCUIT-56]
+	_ = x[ChileanRUT-57]
+	_ = x[IndianAadhaar-58]
+	_ = x[IndianPAN-59]
+	_ = x[SingaporeNRIC-60]
+	_ = x[JapanMyNumber-61]
+	_ = x[KoreanRRN-62]
+	_ = x[ChineseRIC-63]
+	_ = x[AustralianTFN-64]
+	_ = x[AustralianMedicare-65]
+	_ = x[NewZealandIRD-66]
+	_ = x[CanadianSIN-67]
+	_ = x[CAPostalCode-68]
+	_ = x[PostalCode-69]
+	_ = x[DriversLicense-70]
+	_ = x[MRZ-71]
+	_ = x[PassportNumber-72]
+	_ = x[DocumentNumber-73]
+	_ = x[DateYYMMDD-74]
+	_ = x[PersonName-75]
+	_ = x[NPI-76]
+	_ = x[DEA-77]
+	_ = x[MBI-78]
+	_ = x[HICN-79]
+	_ = x[ICD10-80]
+	_ = x[IMEI-81]
+	_ = x[MEID-82]
+	_ = x[ICCID-83]
+	_ = x[IMSI-84]
+	_ = x[MACAddress-85]
+	_ = x[AndroidAdvertisingID-86]
+	_ = x[AppleIDFA-87]
+	_ = x[VIN-88]
+	_ = x[LicensePlate-89]
+	_ = x[UUID-90]
+	_ = x[ULID-91]
+	_ = x[KSUID-92]
+	_ = x[ObjectID-93]
+	_ = x[Snowflake-94]
+	_ = x[NanoID-95]
+	_ = x[URL-96]
+	_ = x[FQDN-97]
+	_ = x[Hostname-98]
+	_ = x[JWT-99]
+	_ = x[ConnectionString-100]
+	_ = x[StreetAddress-101]
+	_ = x[Money-102]
+	_ = x[Geohash-103]
+	_ = x[PlusCode-104]
+}
+
+const _CanonicalType_name = "UnknownUUIDv4IPv4IPv6EmailCountryCode2CountryCode3LanguageCode2LanguageCode3USPostalCodeSSNUSDLatLongDateCCYYMMDDPANAmexPANVisaPANMCPANDiscoverPANDinersPANJCBSecretPANUnionPayPANMaestroPANMirPANRuPayPANEloPANHipercardPANVerveMaskedPANCardExpiryCardCVVTrack1Track2ABARoutingBankAccountBitcoinAddressEthereumAddressLitecoinAddressMoneroAddressSpanishDNISpanishNIEItalianFiscalCodeFrenchNIRGermanTaxIDDutchBSNBelgianNRNPolishPESELSwedishPersonnummerFinnishHETUUKNINOUKNHSUKPostalCodeBrazilianCPFBrazilianCNPJMexicanCURPMexicanRFCArgentineCUITChileanRUTIndianAadhaarIndianPANSingaporeNRICJapanMyNumberKoreanRRNChineseRICAustralianTFNAustralianMedicareNewZealandIRDCanadianSINCAPostalCodePostalCodeDriversLicenseMRZPassportNumberDocumentNumberDateYYMMDDPersonNameNPIDEAMBIHICNICD10IMEIMEIDICCIDIMSIMACAddressAndroidAdvertisingIDAppleIDFAVINLicensePlateUUIDULIDKSUIDObjectIDSnowflakeNanoIDURLFQDNHostnameJWTConnectionStringStreetAddressMoneyGeohashPlusCode"
 
-var _CanonicalType_index = [...]uint8{0, 7, 13, 17, 21, 26, 38, 50, 63, 76, 88, 91, 94, 101, 113, 120, 127, 132, 143, 152, 158, 164}
+var _CanonicalType_index = [...]uint16{0, 7, 13, 17, 21, 26, 38, 50, 63, 76, 88, 91, 94, 101, 113, 120, 127, 132, 143, 152, 158, 164, 175, 185, 191, 199, 205, 217, 225, 234, 244, 251, 257, 263, 273, 284, 298, 313, 328, 341, 351, 361, 378, 387, 398, 406, 416, 427, 446, 457, 463, 468, 480, 492, 505, 516, 526, 539, 549, 562, 571, 584, 597, 606, 616, 629, 647, 660, 671, 683, 693, 707, 710, 724, 738, 748, 758, 761, 764, 767, 771, 776, 780, 784, 789, 793, 803, 823, 832, 835, 847, 851, 855, 860, 868, 877, 883, 886, 890, 898, 901, 917, 930, 935, 942, 950}
 
 func (i CanonicalType) String() string {
-	if i < 0 || i >= CanonicalType(len(_CanonicalType_index)-1) {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_CanonicalType_index)-1 {
 		return "CanonicalType(" + strconv.FormatInt(int64(i), 10) + ")"
 	}
-	return _CanonicalType_name[_CanonicalType_index[i]:_CanonicalType_index[i+1]]
+	return _CanonicalType_name[_CanonicalType_index[idx]:_CanonicalType_index[idx+1]]
 }
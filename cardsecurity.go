@@ -0,0 +1,72 @@
+package inspectdata
+
+import (
+	"regexp"
+)
+
+// Regular Expressions for card security data
+// Track 1: %B PAN ^ NAME ^ YYMM SVC DD ? and Track 2: ; PAN = YYMM SVC DD ? where the
+// start sentinel is optional and an omitted expiry or service code is just its separator.
+const reCardExpiry = `^(0[1-9]|1[0-2]) ?[/-] ?([0-9]{2}|20[0-9]{2})$` // MM/YY, MM-YY or MM/YYYY
+const reCardExpiryMMYY = `^(0[1-9]|1[0-2])([0-9]{2})$`
+const reCVV = `^[0-9]{3,4}$`
+const reTrack1 = `^%?[Bb]([0-9]{12,19})\^([^^]{2,26})\^([0-9]{4}|\^)([0-9]{3}|\^)([^?]*)\?.?$`
+const reTrack2 = `^;?([0-9]{12,19})=([0-9]{4}|=)([0-9]{3}|=)([0-9]*)\?.?$`
+
+var validCardExpiry = regexp.MustCompile(reCardExpiry)
+var validCardExpiryMMYY = regexp.MustCompile(reCardExpiryMMYY)
+var validCVV = regexp.MustCompile(reCVV)
+var validTrack1 = regexp.MustCompile(reTrack1)
+var validTrack2 = regexp.MustCompile(reTrack2)
+
+// Field name hints indicating card security data
+var cvvHints = []string{"cvv", "cvv2", "cvc", "cvc2", "cid", "csc", "securitycode", "cardcode", "cardverification"}
+var expiryHints = []string{"exp", "expiry", "expiration", "expires", "expdate", "validthru", "validto"}
+var cardHints = []string{"card", "cc", "pan", "creditcard", "debitcard"}
+
+// Parses the PAN and expiration date (YYMM) embedded in Track 1 or Track 2 magnetic stripe data.
+// Returns false if the track data is malformed or the embedded PAN is invalid.
+func parseTrack(v string) (track CanonicalType, pan string, expiry string, ok bool) {
+	if m := validTrack1.FindStringSubmatch(v); m != nil {
+		track, pan, expiry = Track1, m[1], m[3]
+	} else if m := validTrack2.FindStringSubmatch(v); m != nil {
+		track, pan, expiry = Track2, m[1], m[2]
+	} else {
+		return Unknown, "", "", false
+	}
+	if inspectPAN(pan) == Unknown {
+		return Unknown, "", "", false
+	}
+	if !isDigits(expiry) {
+		expiry = "" // field separator denotes omitted expiration date
+	}
+	return track, pan, expiry, true
+}
+
+// Inspects the string to determine if it is Track 1 or Track 2 magnetic stripe data with a valid PAN.
+// Returns Unknown if the string is not track data.
+func inspectTrack(v string) CanonicalType {
+	track, _, _, _ := parseTrack(v)
+	return track
+}
+
+// Generates the card details for the PAN and expiration date embedded in magnetic stripe track data.
+func trackCardInfo(v string) *CardInfo {
+	_, pan, expiry, _ := parseTrack(v)
+	info := cardInfo(pan)
+	if expiry != "" {
+		info.Expiry = expiry[2:] + "/" + expiry[:2]
+	}
+	return info
+}
+
+// Generates the card details for a card expiration date, normalized to MM/YY.
+func expiryCardInfo(v string) *CardInfo {
+	info := &CardInfo{}
+	if m := validCardExpiry.FindStringSubmatch(v); m != nil {
+		info.Expiry = m[1] + "/" + m[2][len(m[2])-2:]
+	} else if m := validCardExpiryMMYY.FindStringSubmatch(v); m != nil {
+		info.Expiry = m[1] + "/" + m[2]
+	}
+	return info
+}
@@ -0,0 +1,90 @@
+package inspectdata
+
+import (
+	"testing"
+)
+
+func TestInspectCardExpiry(t *testing.T) {
+	expiries := map[string]string{"12/25": "12/25", "01/2030": "01/30", "06-27": "06/27", "09 / 26": "09/26", "1225": "12/25"}
+	for v, expected := range expiries {
+		datum, err := InspectWithOptions(v, Options{Field: "card_exp"})
+		if err != nil {
+			t.Error(err)
+		}
+		if datum.Canonical != CardExpiry || datum.Card.Expiry != expected {
+			t.Errorf("InspectWithOptions should have detected CardExpiry %s for %s with context, but got: %v %+v", expected, v, datum.Canonical, datum.Card)
+		}
+	}
+	datum, _ := InspectWithOptions("12/25", Options{Field: "cardValid"})
+	if datum.Canonical != CardExpiry {
+		t.Errorf("InspectWithOptions should have detected CardExpiry with card context, but got: %v", datum.Canonical)
+	}
+	datum, _ = InspectWithOptions("1225", Options{Field: "card"})
+	if datum.Canonical == CardExpiry {
+		t.Errorf("InspectWithOptions should not have detected CardExpiry for MMYY without expiry context")
+	}
+	datum, _ = InspectWithOptions("13/25", Options{Field: "card_exp"})
+	if datum.Canonical == CardExpiry {
+		t.Errorf("InspectWithOptions should not have detected canonical type CardExpiry for month 13")
+	}
+
+	// dates and ratios without context
+	for _, v := range []string{"12/25", "06-27", "01/2030", "1225"} {
+		if c, _ := inspectString(v); c == CardExpiry {
+			t.Errorf("inspectString should not have detected canonical type CardExpiry for %s without context", v)
+		}
+	}
+}
+
+func TestInspectCVV(t *testing.T) {
+	fields := []string{"cvv", "CVC2", "card_security_code", "cid"}
+	for _, field := range fields {
+		datum, _ := InspectWithOptions("4321", Options{Field: field})
+		if datum.Canonical != CardCVV {
+			t.Errorf("InspectWithOptions should have detected CardCVV for field %s, but got: %v", field, datum.Canonical)
+		}
+	}
+	datum, _ := InspectWithOptions("12345", Options{Field: "cvv"})
+	if datum.Canonical == CardCVV {
+		t.Errorf("InspectWithOptions should not have detected CardCVV for 5 digits")
+	}
+	datum, _ = InspectWithOptions("123", Options{Field: "quantity"})
+	if datum.Canonical == CardCVV {
+		t.Errorf("InspectWithOptions should not have detected CardCVV for unrelated field")
+	}
+}
+
+func TestInspectTrack(t *testing.T) {
+	c, _ := inspectString("%B4111111111111111^DOE/JOHN^2512101000000000000000?")
+	if c != Track1 {
+		t.Errorf("inspectString should have detected canonical type Track1, but got: %v", c)
+	}
+	c, _ = inspectString("B4111111111111111^DOE/JOHN^^^?")
+	if c != Track1 {
+		t.Errorf("inspectString should have detected canonical type Track1 without expiry, but got: %v", c)
+	}
+	c, _ = inspectString(";4111111111111111=25121010000000000?")
+	if c != Track2 {
+		t.Errorf("inspectString should have detected canonical type Track2, but got: %v", c)
+	}
+	c, _ = inspectString(";4111111111111112=25121010000000000?")
+	if c == Track2 {
+		t.Errorf("inspectString should not have detected canonical type Track2 with invalid PAN")
+	}
+	c, _ = inspectString("%B4111111111111112^DOE/JOHN^2512101?")
+	if c == Track1 {
+		t.Errorf("inspectString should not have detected canonical type Track1 with invalid PAN")
+	}
+
+	datum, err := Inspect("%B4111111111111111^DOE/JOHN^2512101000000000000000?")
+	if err != nil {
+		t.Error(err)
+	}
+	if !datum.IsPCI || datum.Card.PAN != "4111111111111111" || datum.Card.Expiry != "12/25" {
+		t.Errorf("Inspect should have parsed PAN and expiry from Track1, but got: %+v", datum.Card)
+	}
+	datum, _ = Inspect(";5500005555555559=2706101?")
+	if datum.Canonical != Track2 || datum.Card.PAN != "5500005555555559" || datum.Card.Expiry != "06/27" {
+		t.Errorf("Inspect should have parsed PAN and expiry from Track2, but got: %v %+v", datum.Canonical, datum.Card)
+	}
+}
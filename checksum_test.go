@@ -0,0 +1,58 @@
+package inspectdata
+
+import (
+	"testing"
+)
+
+func TestLuhn(t *testing.T) {
+	valid := []string{"4111111111111111", "371449635398431", "79927398713", "0"}
+	for _, v := range valid {
+		if !luhn(v) {
+			t.Errorf("luhn should have validated %s", v)
+		}
+	}
+
+	invalid := []string{"4111111111111112", "79927398710", "", "4111-1111-1111-1111", "abc"}
+	for _, v := range invalid {
+		if luhn(v) {
+			t.Errorf("luhn should not have validated %s", v)
+		}
+	}
+}
+
+func TestIsDigits(t *testing.T) {
+	if !isDigits("0123456789") {
+		t.Errorf("isDigits should have detected all digits")
+	}
+	if isDigits("") {
+		t.Errorf("isDigits should not have detected digits in empty string")
+	}
+	if isDigits("12a4") {
+		t.Errorf("isDigits should not have detected all digits in 12a4")
+	}
+}
+
+func TestVerhoeff(t *testing.T) {
+	valid := []string{"2363", "234123412346", "499185226002"}
+	for _, v := range valid {
+		if !verhoeff(v) {
+			t.Errorf("verhoeff should have validated %s", v)
+		}
+	}
+
+	invalid := []string{"2364", "234123412347", "", "2341 2341 2346"}
+	for _, v := range invalid {
+		if verhoeff(v) {
+			t.Errorf("verhoeff should not have validated %s", v)
+		}
+	}
+}
+
+func TestISO7064Mod112(t *testing.T) {
+	checks := map[string]byte{"11010519491231002": 'X', "44030819800101001": '2', "0794": '0'}
+	for v, expected := range checks {
+		if c := iso7064Mod112(v); c != expected {
+			t.Errorf("iso7064Mod112 should have calculated %c for %s, but got: %c", expected, v, c)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package inspectdata
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Splits a field name into lowercase alphanumeric tokens on separators and camelCase boundaries.
+// Example: "cardCVV2" and "card_cvv2" both become ["card", "cvv2"]
+func fieldTokens(field string) []string {
+	var tokens []string
+	var token []rune
+	var prev rune
+	flush := func() {
+		if len(token) > 0 {
+			tokens = append(tokens, strings.ToLower(string(token)))
+			token = token[:0]
+		}
+	}
+	for _, r := range field {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+		case unicode.IsUpper(r) && unicode.IsLower(prev):
+			flush()
+			token = append(token, r)
+		default:
+			token = append(token, r)
+		}
+		prev = r
+	}
+	flush()
+	return tokens
+}
+
+// Determines if the field name indicates any of the given lowercase alphanumeric hints.
+// A hint matches one or more consecutive whole tokens of the field name joined, ex: hint "license"
+// and hint "driverslicense" match "DriversLicenseNo", but hint "card" does not match "discarded_at".
+func hasFieldHint(field string, hints ...string) bool {
+	if field == "" {
+		return false
+	}
+	tokens := fieldTokens(field)
+	for _, hint := range hints {
+		for i := range tokens {
+			joined := ""
+			for _, token := range tokens[i:] {
+				if joined += token; joined == hint {
+					return true
+				} else if len(joined) >= len(hint) || !strings.HasPrefix(hint, joined) {
+					break
+				}
+			}
+		}
+	}
+	return false
+}
+
+// Inspects the string using the context of the inspect options to determine canonical types
+// that are too ambiguous to identify from the data alone, ex: a CVV is any 3 or 4 digits.
+// Returns Unknown if the options do not indicate a canonical type for the data.
+func inspectContext(v string, opts Options) CanonicalType {
+	if c := inspectIdentifierContext(v, opts); c != Unknown {
+		return c
+	}
+	// card data keeps its PCI scope in any field, ex: a PAN in a field named account_number, other than device
+	// identifiers sharing the Luhn check ex: an IMEI
+	if isCardData(v) {
+		return inspectDeviceContext(v, opts)
+	}
+	if opts.Field != "" {
+		switch {
+		case hasFieldHint(opts.Field, cvvHints...) && validCVV.MatchString(v):
+			return CardCVV
+		case hasFieldHint(opts.Field, expiryHints...) && (validCardExpiry.MatchString(v) || validCardExpiryMMYY.MatchString(v)):
+			return CardExpiry
+		// a standalone MM/YY is as likely a date or ratio as a card expiry ex: 12/25
+		case hasFieldHint(opts.Field, cardHints...) && validCardExpiry.MatchString(v):
+			return CardExpiry
+		case hasFieldHint(opts.Field, icd10Hints...) && (validICD10.MatchString(v) || validICD10Bare.MatchString(v)):
+			return ICD10
+		}
+		if c := inspectBankContext(v, opts); c != Unknown {
+			return c
+		}
+		if c := inspectPassportContext(v, opts); c != Unknown {
+			return c
+		}
+		if c := inspectDeviceContext(v, opts); c != Unknown {
+			return c
+		}
+		if c := inspectVehicleContext(v, opts); c != Unknown {
+			return c
+		}
+		if c := inspectIDContext(v, opts); c != Unknown {
+			return c
+		}
+		if c := inspectHostnameContext(v, opts); c != Unknown {
+			return c
+		}
+		if c := inspectAddressContext(v, opts); c != Unknown {
+			return c
+		}
+		if c := inspectPersonName(v, opts); c != Unknown {
+			return c
+		}
+	}
+	if c := inspectDriversLicenseContext(v, opts); c != Unknown {
+		return c
+	}
+	return inspectPostalContext(v, opts)
+}
+
+// Determines if the data is a PAN or magnetic stripe track data by its BIN range and Luhn check alone.
+func isCardData(v string) bool {
+	return inspectPAN(normalizePAN(v)) != Unknown || inspectTrack(v) != Unknown
+}
@@ -0,0 +1,76 @@
+package inspectdata
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFieldTokens(t *testing.T) {
+	fields := map[string][]string{
+		"card_cvv2":         {"card", "cvv2"},
+		"cardCVV2":          {"card", "cvv2"},
+		"Driver-License No": {"driver", "license", "no"},
+		"":                  nil,
+	}
+	for field, expected := range fields {
+		if tokens := fieldTokens(field); !reflect.DeepEqual(tokens, expected) {
+			t.Errorf("fieldTokens of %s should be %v, but got: %v", field, expected, tokens)
+		}
+	}
+}
+
+func TestHasFieldHint(t *testing.T) {
+	if !hasFieldHint("card_cvv", "cvv") {
+		t.Errorf("hasFieldHint should have matched token cvv")
+	}
+	if !hasFieldHint("cardExpirationDate", "expiration") {
+		t.Errorf("hasFieldHint should have matched expiration within field name")
+	}
+	if hasFieldHint("export_date", "exp") {
+		t.Errorf("hasFieldHint should not have matched short hint exp within token export")
+	}
+	if hasFieldHint("", "cvv") {
+		t.Errorf("hasFieldHint should not have matched empty field")
+	}
+	if !hasFieldHint("DriversLicenseNo", "driverslicense") {
+		t.Errorf("hasFieldHint should have matched driverslicense across tokens of the field name")
+	}
+
+	// hints within a token rather than whole tokens
+	for field, hint := range map[string]string{"discarded_at": "card", "mailbox_size": "mail", "ipaddress_count": "address"} {
+		if hasFieldHint(field, hint) {
+			t.Errorf("hasFieldHint should not have matched hint %s within the tokens of %s", hint, field)
+		}
+	}
+	if datum, _ := InspectWithOptions("12/25", Options{Field: "discarded_at"}); datum.Canonical == CardExpiry {
+		t.Errorf("InspectWithOptions should not have detected CardExpiry in field discarded_at")
+	}
+}
+
+func TestInspectWithOptions(t *testing.T) {
+	datum, err := InspectWithOptions("123", Options{Field: "cvv"})
+	if err != nil {
+		t.Error(err)
+	}
+	if datum.Canonical != CardCVV || !datum.IsPCI {
+		t.Errorf("InspectWithOptions should have detected PCI canonical type CardCVV, but got: %v", datum.Canonical)
+	}
+
+	// no context
+	datum, err = InspectWithOptions("123", Options{})
+	if err == nil {
+		t.Errorf("InspectWithOptions should not be able to inspect 3 digits without context")
+	}
+	if datum.Canonical == CardCVV {
+		t.Errorf("InspectWithOptions should not have detected CardCVV without context")
+	}
+
+	// context does not apply to data so falls back on inspecting the data alone
+	datum, err = InspectWithOptions("bob@mail.com", Options{Field: "cvv"})
+	if err != nil {
+		t.Error(err)
+	}
+	if datum.Canonical != Email {
+		t.Errorf("InspectWithOptions should have detected canonical type Email, but got: %v", datum.Canonical)
+	}
+}
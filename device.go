@@ -0,0 +1,100 @@
+package inspectdata
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Regular Expressions for device and hardware identifiers
+const reIMEI = "^[0-9]{2}-?[0-9]{6}-?[0-9]{6}-?[0-9]$"
+const reMEID = "^[A-F][0-9A-F]{13}$"
+const reICCID = "^89[0-9]{17,18}$"
+const reIMSI = "^[2-7][0-9]{14}$"
+const reMACColon = "^[0-9A-Fa-f]{2}(:[0-9A-Fa-f]{2}){5}$"
+const reMACDash = "^[0-9A-Fa-f]{2}(-[0-9A-Fa-f]{2}){5}$"
+const reMACDot = `^[0-9A-Fa-f]{4}\.[0-9A-Fa-f]{4}\.[0-9A-Fa-f]{4}$`
+const reAdvertisingID = "^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"
+
+var validIMEI = regexp.MustCompile(reIMEI)
+var validMEID = regexp.MustCompile(reMEID)
+var validICCID = regexp.MustCompile(reICCID)
+var validIMSI = regexp.MustCompile(reIMSI)
+var validMACColon = regexp.MustCompile(reMACColon)
+var validMACDash = regexp.MustCompile(reMACDash)
+var validMACDot = regexp.MustCompile(reMACDot)
+var validAdvertisingID = regexp.MustCompile(reAdvertisingID)
+
+// Field name hints indicating device identifiers only identified with context
+var imsiHints = []string{"imsi"}
+var advertisingIDHints = []string{"idfa", "aaid", "gaid", "adid", "advertisingid", "adsid"}
+
+// Device and hardware identifier details
+type DeviceInfo struct {
+	ID                  string // Identifier normalized with dashes removed, MAC address as lowercase colon notation, advertising ID as is
+	TAC                 string // IMEI Type Allocation Code identifying the device model: leading 8 digits
+	MCC                 string // IMSI Mobile Country Code: leading 3 digits
+	LocallyAdministered bool   // MAC address is locally administered (ex: randomized for privacy) rather than vendor assigned
+	Multicast           bool   // MAC address is a multicast group rather than a single device
+}
+
+// Inspects the string to determine if it is a device identifier distinctive from the data alone:
+// IMEI and ICCID with valid Luhn check digits, MEID or MAC address.
+// Returns Unknown if the string is not such a device identifier.
+func inspectDevice(v string) CanonicalType {
+	switch {
+	case validIMEI.MatchString(v) && luhn(removeSeparators(v, "-")):
+		return IMEI
+	case validICCID.MatchString(v) && luhn(v):
+		return ICCID
+	case validMEID.MatchString(v):
+		return MEID
+	case validMACColon.MatchString(v) || validMACDash.MatchString(v) || validMACDot.MatchString(v):
+		return MACAddress
+	}
+	return Unknown
+}
+
+// Inspects the string using the field name of the options to determine if it is an IMSI or mobile advertising ID,
+// where Apple IDFA is written uppercase and the Android advertising ID lowercase.
+// Returns Unknown if the options do not indicate such a device identifier for the data.
+func inspectDeviceContext(v string, opts Options) CanonicalType {
+	switch {
+	case hasFieldHint(opts.Field, imsiHints...) && validIMSI.MatchString(v):
+		return IMSI
+	case hasFieldHint(opts.Field, advertisingIDHints...) && !strings.HasPrefix(v, "00000000-"):
+		if validAdvertisingID.MatchString(v) {
+			return AndroidAdvertisingID
+		}
+		if validAdvertisingID.MatchString(strings.ToLower(v)) && v == strings.ToUpper(v) {
+			return AppleIDFA
+		}
+	}
+	return Unknown
+}
+
+// Extracts the details of the device identifier.
+func deviceInfo(c CanonicalType, v string) *DeviceInfo {
+	info := &DeviceInfo{ID: strings.ToLower(removeSeparators(v, "-"))}
+	switch c {
+	case IMEI:
+		info.TAC = info.ID[:8]
+	case IMSI:
+		info.MCC = info.ID[:3]
+	case MEID:
+		info.ID = strings.ToUpper(info.ID)
+	case MACAddress:
+		hex := strings.ToLower(removeSeparators(v, ":-."))
+		pairs := make([]string, 0, 6)
+		for i := 0; i < len(hex); i += 2 {
+			pairs = append(pairs, hex[i:i+2])
+		}
+		info.ID = strings.Join(pairs, ":")
+		first, _ := strconv.ParseUint(hex[:2], 16, 8)
+		info.LocallyAdministered = first&0x02 != 0
+		info.Multicast = first&0x01 != 0
+	case AndroidAdvertisingID, AppleIDFA:
+		info.ID = v
+	}
+	return info
+}
@@ -0,0 +1,90 @@
+package inspectdata
+
+import (
+	"testing"
+)
+
+func TestInspectDevice(t *testing.T) {
+	ids := map[string]CanonicalType{
+		"356938035643809":      IMEI,
+		"35-209900-176148-1":   IMEI,
+		"8910042348144559361":  ICCID,
+		"89445001021983048261": ICCID,
+		"A10000009296F2":       MEID,
+		"00:1A:2B:3C:4D:5E":    MACAddress,
+		"00-1a-2b-3c-4d-5e":    MACAddress,
+		"001a.2b3c.4d5e":       MACAddress,
+	}
+	for v, expected := range ids {
+		datum, err := Inspect(v)
+		if err != nil {
+			t.Error(err)
+		}
+		if datum.Canonical != expected || !datum.IsPII || datum.Device == nil {
+			t.Errorf("Inspect should have detected PII %v with device details for %s, but got: %+v", expected, v, datum)
+		}
+	}
+
+	// invalid check digits, mixed MAC separators
+	invalid := map[string]CanonicalType{
+		"356938035643808":     IMEI,
+		"8910042348144559362": ICCID,
+		"00:1A-2B:3C-4D:5E":   MACAddress,
+		"00:1A:2B:3C:4D":      MACAddress,
+	}
+	for v, unexpected := range invalid {
+		if c, _ := inspectString(v); c == unexpected {
+			t.Errorf("inspectString should not have detected canonical type %v for %s", unexpected, v)
+		}
+	}
+}
+
+func TestDeviceInfo(t *testing.T) {
+	datum, _ := Inspect("35-209900-176148-1")
+	if datum.Device.ID != "352099001761481" || datum.Device.TAC != "35209900" {
+		t.Errorf("IMEI should have been normalized with TAC 35209900, but got: %+v", datum.Device)
+	}
+
+	datum, _ = Inspect("001A.2B3C.4D5E")
+	if datum.Device.ID != "00:1a:2b:3c:4d:5e" || datum.Device.LocallyAdministered || datum.Device.Multicast {
+		t.Errorf("MAC address should have been normalized as vendor assigned unicast, but got: %+v", datum.Device)
+	}
+
+	datum, _ = Inspect("DA:A1:19:00:11:22")
+	if !datum.Device.LocallyAdministered || datum.Device.Multicast {
+		t.Errorf("MAC address should have been reported locally administered, but got: %+v", datum.Device)
+	}
+
+	datum, _ = Inspect("01:00:5e:00:00:fb")
+	if datum.Device.LocallyAdministered || !datum.Device.Multicast {
+		t.Errorf("MAC address should have been reported multicast, but got: %+v", datum.Device)
+	}
+}
+
+func TestInspectDeviceContext(t *testing.T) {
+	contexts := []struct {
+		data     string
+		opts     Options
+		expected CanonicalType
+	}{
+		{"310150123456789", Options{Field: "imsi"}, IMSI},
+		{"38400000-8cf0-41bd-b23e-10b96e4ef00d", Options{Field: "advertising_id"}, AndroidAdvertisingID},
+		{"6D92078A-8246-4BA4-AE5B-76104861E7DC", Options{Field: "idfa"}, AppleIDFA},
+		{"00000000-0000-0000-0000-000000000000", Options{Field: "idfa"}, Unknown},
+		{"6D92078A-8246-4ba4-AE5B-76104861E7DC", Options{Field: "idfa"}, Unknown},
+		{"38400000-8cf0-41bd-b23e-10b96e4ef00d", Options{Field: "session_id"}, Unknown},
+	}
+	for _, ctx := range contexts {
+		if c := inspectContext(ctx.data, ctx.opts); c != ctx.expected {
+			t.Errorf("inspectContext should have detected %v for %s with %+v, but got: %v", ctx.expected, ctx.data, ctx.opts, c)
+		}
+	}
+
+	datum, err := InspectWithOptions("310150123456789", Options{Field: "imsi"})
+	if err != nil {
+		t.Error(err)
+	}
+	if !datum.IsPII || datum.Device.MCC != "310" {
+		t.Errorf("InspectWithOptions should have detected PII IMSI with MCC 310, but got: %+v", datum)
+	}
+}
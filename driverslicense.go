@@ -0,0 +1,106 @@
+package inspectdata
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Driver's license number format of a US state or DC
+type licenseFormat struct {
+	state  string         // State USPS abbreviation ex: CA
+	format *regexp.Regexp // Number format with spaces and dashes removed
+}
+
+// Field name hints indicating a driver's license number
+var driversLicenseHints = []string{"dl", "dln", "driverslicense", "driverlicense", "driverslicence", "driverlicence",
+	"licenseno", "licensenumber", "licenceno", "licencenumber", "licno"}
+
+// Social Security number layout, unlike the plain 9 digits of driver's licenses in states such as CT and MS
+var validSSNLayout = regexp.MustCompile("^[0-9]{3}[ -][0-9]{2}[ -][0-9]{4}$")
+
+// Driver's license number formats of the 50 states plus DC
+var licenseFormats = []licenseFormat{
+	{"AK", regexp.MustCompile("^[0-9]{1,7}$")},
+	{"AL", regexp.MustCompile("^[0-9]{1,8}$")},
+	{"AR", regexp.MustCompile("^[0-9]{4,9}$")},
+	{"AZ", regexp.MustCompile("^([A-Z][0-9]{8}|[0-9]{9})$")},
+	{"CA", regexp.MustCompile("^[A-Z][0-9]{7}$")},
+	{"CO", regexp.MustCompile("^([0-9]{9}|[A-Z][0-9]{3,6}|[A-Z]{2}[0-9]{2,5})$")},
+	{"CT", regexp.MustCompile("^[0-9]{9}$")},
+	{"DC", regexp.MustCompile("^([0-9]{7}|[0-9]{9})$")},
+	{"DE", regexp.MustCompile("^[0-9]{1,7}$")},
+	{"FL", regexp.MustCompile("^[A-Z][0-9]{12}$")},
+	{"GA", regexp.MustCompile("^[0-9]{7,9}$")},
+	{"HI", regexp.MustCompile("^([A-Z][0-9]{8}|[0-9]{9})$")},
+	{"IA", regexp.MustCompile("^([0-9]{9}|[0-9]{3}[A-Z]{2}[0-9]{4})$")},
+	{"ID", regexp.MustCompile("^([A-Z]{2}[0-9]{6}[A-Z]|[0-9]{9})$")},
+	{"IL", regexp.MustCompile("^[A-Z][0-9]{11,12}$")},
+	{"IN", regexp.MustCompile("^([A-Z][0-9]{9}|[0-9]{9,10})$")},
+	{"KS", regexp.MustCompile("^([A-Z][0-9][A-Z][0-9][A-Z]|[A-Z][0-9]{8}|[0-9]{9})$")},
+	{"KY", regexp.MustCompile("^([A-Z][0-9]{8,9}|[0-9]{9})$")},
+	{"LA", regexp.MustCompile("^[0-9]{1,9}$")},
+	{"MA", regexp.MustCompile("^([A-Z][0-9]{8}|[0-9]{9})$")},
+	{"MD", regexp.MustCompile("^[A-Z][0-9]{12}$")},
+	{"ME", regexp.MustCompile("^([0-9]{7,8}|[0-9]{7}[A-Z])$")},
+	{"MI", regexp.MustCompile("^([A-Z][0-9]{10}|[A-Z][0-9]{12})$")},
+	{"MN", regexp.MustCompile("^[A-Z][0-9]{12}$")},
+	{"MO", regexp.MustCompile("^([A-Z][0-9]{5,9}|[A-Z][0-9]{6}R|[0-9]{8}[A-Z]{2}|[0-9]{9}[A-Z]?)$")},
+	{"MS", regexp.MustCompile("^[0-9]{9}$")},
+	{"MT", regexp.MustCompile("^([A-Z][0-9]{8}|[0-9]{9}|[0-9]{13,14})$")},
+	{"NC", regexp.MustCompile("^[0-9]{1,12}$")},
+	{"ND", regexp.MustCompile("^([A-Z]{3}[0-9]{6}|[0-9]{9})$")},
+	{"NE", regexp.MustCompile("^[A-Z][0-9]{6,8}$")},
+	{"NH", regexp.MustCompile("^[0-9]{2}[A-Z]{3}[0-9]{5}$")},
+	{"NJ", regexp.MustCompile("^[A-Z][0-9]{14}$")},
+	{"NM", regexp.MustCompile("^[0-9]{8,9}$")},
+	{"NV", regexp.MustCompile("^([0-9]{9,10}|[0-9]{12}|X[0-9]{8})$")},
+	{"NY", regexp.MustCompile("^([A-Z][0-9]{7}|[A-Z][0-9]{18}|[0-9]{8,9}|[0-9]{16}|[A-Z]{8})$")},
+	{"OH", regexp.MustCompile("^([A-Z][0-9]{4,8}|[A-Z]{2}[0-9]{3,7}|[0-9]{8})$")},
+	{"OK", regexp.MustCompile("^([A-Z][0-9]{9}|[0-9]{9})$")},
+	{"OR", regexp.MustCompile("^[0-9]{1,9}$")},
+	{"PA", regexp.MustCompile("^[0-9]{8}$")},
+	{"RI", regexp.MustCompile("^([0-9]{7}|[A-Z][0-9]{6})$")},
+	{"SC", regexp.MustCompile("^[0-9]{5,11}$")},
+	{"SD", regexp.MustCompile("^([0-9]{6,10}|[0-9]{12})$")},
+	{"TN", regexp.MustCompile("^[0-9]{7,9}$")},
+	{"TX", regexp.MustCompile("^[0-9]{7,8}$")},
+	{"UT", regexp.MustCompile("^[0-9]{4,10}$")},
+	{"VA", regexp.MustCompile("^([A-Z][0-9]{8,11}|[0-9]{9})$")},
+	{"VT", regexp.MustCompile("^([0-9]{8}|[0-9]{7}A)$")},
+	{"WA", regexp.MustCompile(`^([A-Z][A-Z*]{4}[A-Z][A-Z*][0-9]{3}[A-Z0-9]{2}|WDL[A-Z0-9]{9})$`)},
+	{"WI", regexp.MustCompile("^[A-Z][0-9]{13}$")},
+	{"WV", regexp.MustCompile("^([0-9]{7}|[A-Z]{1,2}[0-9]{5,6})$")},
+	{"WY", regexp.MustCompile("^[0-9]{9,10}$")},
+}
+
+// Finds the US states whose driver's license number format matches the data, restricted to the state
+// of the options if any. Spaces and dashes are ignored, ex: D123-4567-8901 is a Florida format.
+func driversLicenseStates(v string, opts Options) []string {
+	v = strings.ToUpper(removeSeparators(v, " -"))
+	var states []string
+	for _, l := range licenseFormats {
+		if opts.State != "" && !strings.EqualFold(l.state, opts.State) {
+			continue
+		}
+		if l.format.MatchString(v) {
+			states = append(states, l.state)
+		}
+	}
+	return states
+}
+
+// Inspects the string using the state or field name of the options to determine if it is a US driver's
+// license number. Returns Unknown if the options do not indicate a driver's license matching a state format.
+func inspectDriversLicenseContext(v string, opts Options) CanonicalType {
+	if opts.State == "" && !hasFieldHint(opts.Field, driversLicenseHints...) {
+		return Unknown
+	}
+	// SSNs keep their own canonical type as PANs do ahead of context, ex: 123-45-6789 matches the 9 digit formats
+	if validSSNLayout.MatchString(v) {
+		return Unknown
+	}
+	if len(driversLicenseStates(v, opts)) == 0 {
+		return Unknown
+	}
+	return DriversLicense
+}
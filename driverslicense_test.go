@@ -0,0 +1,80 @@
+package inspectdata
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestInspectDriversLicense(t *testing.T) {
+	c, _ := inspectString("A1234567")
+	if c == DriversLicense {
+		t.Errorf("inspectString should not have detected canonical type DriversLicense without context")
+	}
+
+	datum, err := InspectWithOptions("A1234567", Options{Field: "dl"})
+	if err != nil {
+		t.Error(err)
+	}
+	if datum.Canonical != DriversLicense || !datum.IsPII || datum.Country != "US" {
+		t.Errorf("InspectWithOptions should have detected PII US DriversLicense, but got: %+v", datum)
+	}
+	if !reflect.DeepEqual(datum.States, []string{"CA", "MO", "NE", "NY", "OH"}) {
+		t.Errorf("InspectWithOptions should have reported candidate states CA, MO, NE, NY and OH, but got: %v", datum.States)
+	}
+
+	datum, _ = InspectWithOptions("D123-456-78-901-0", Options{Field: "drivers_license_number"})
+	if datum.Canonical != DriversLicense || !reflect.DeepEqual(datum.States, []string{"FL", "IL", "MD", "MI", "MN"}) {
+		t.Errorf("InspectWithOptions should have detected DriversLicense for FL, IL, MD, MI and MN, but got: %+v", datum)
+	}
+
+	datum, _ = InspectWithOptions("12ABC34567", Options{Field: "LicenseNo"})
+	if datum.Canonical != DriversLicense || !reflect.DeepEqual(datum.States, []string{"NH"}) {
+		t.Errorf("InspectWithOptions should have detected DriversLicense for NH, but got: %+v", datum)
+	}
+
+	datum, _ = InspectWithOptions("WDLABCD1234E", Options{State: "wa"})
+	if datum.Canonical != DriversLicense || !reflect.DeepEqual(datum.States, []string{"WA"}) {
+		t.Errorf("InspectWithOptions should have detected DriversLicense for WA, but got: %+v", datum)
+	}
+
+	// state option restricts to its format
+	datum, _ = InspectWithOptions("A1234567", Options{State: "PA"})
+	if datum.Canonical == DriversLicense {
+		t.Errorf("InspectWithOptions should not have detected DriversLicense for PA, but got: %+v", datum)
+	}
+	datum, _ = InspectWithOptions("ABC-DEF", Options{Field: "dl"})
+	if datum.Canonical == DriversLicense {
+		t.Errorf("InspectWithOptions should not have detected DriversLicense matching no state format, but got: %+v", datum)
+	}
+
+	// a PAN matching the 16 digit NY format remains card data, and a SSN remains a SSN
+	datum, _ = InspectWithOptions("4111111111111111", Options{Field: "dl"})
+	if datum.Canonical != PANVisa || !datum.IsPCI {
+		t.Errorf("InspectWithOptions should have detected PCI PANVisa in a license field, but got: %+v", datum)
+	}
+	datum, _ = InspectWithOptions("4111-1111-1111-1111", Options{State: "NY"})
+	if datum.Canonical != PANVisa {
+		t.Errorf("InspectWithOptions should have detected PANVisa for NY, but got: %+v", datum)
+	}
+	datum, _ = InspectWithOptions("123-45-6789", Options{Field: "license_number"})
+	if datum.Canonical != SSN {
+		t.Errorf("InspectWithOptions should have detected SSN in a license field, but got: %+v", datum)
+	}
+	datum, _ = InspectWithOptions("123456789", Options{State: "CT"})
+	if datum.Canonical != DriversLicense {
+		t.Errorf("InspectWithOptions should have detected 9 digit DriversLicense for CT, but got: %+v", datum)
+	}
+}
+
+func TestDriversLicenseFormats(t *testing.T) {
+	if len(licenseFormats) != 51 {
+		t.Errorf("licenseFormats should have formats for 50 states plus DC, but got: %d", len(licenseFormats))
+	}
+	seen := map[string]bool{}
+	for _, l := range licenseFormats {
+		if seen[l.state] {
+			t.Errorf("licenseFormats should not have duplicate state %s", l.state)
+		}
+		seen[l.state] = true
+	}
+}
@@ -0,0 +1,93 @@
+package inspectdata
+
+import (
+	"strings"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/net/publicsuffix"
+)
+
+// Domains of free email providers, where users can register personal addresses
+var FreeMailDomains = stringSet(
+	"gmail.com googlemail.com yahoo.com yahoo.co.uk yahoo.fr yahoo.de yahoo.co.jp ymail.com rocketmail.com",
+	"outlook.com hotmail.com hotmail.co.uk hotmail.fr live.com msn.com aol.com icloud.com me.com mac.com",
+	"gmx.com gmx.de gmx.net web.de t-online.de mail.com email.com protonmail.com proton.me pm.me tutanota.com",
+	"fastmail.com zoho.com yandex.ru yandex.com mail.ru inbox.ru qq.com 163.com 126.com sina.com naver.com",
+	"hanmail.net daum.net laposte.net orange.fr free.fr libero.it virgilio.it seznam.cz wp.pl o2.pl interia.pl",
+	"rediffmail.com bol.com.br uol.com.br",
+)
+
+// Domains of disposable (temporary) email providers
+var DisposableEmailDomains = stringSet(
+	"mailinator.com guerrillamail.com guerrillamail.net sharklasers.com grr.la 10minutemail.com temp-mail.org",
+	"tempmail.com yopmail.com yopmail.fr trashmail.com throwawaymail.com getnada.com maildrop.cc dispostable.com",
+	"mailnesia.com fakeinbox.com mintemail.com emailondeck.com mohmal.com spamgourmet.com discard.email",
+	"mailcatch.com tempr.email 33mail.com burnermail.io mytemp.email",
+)
+
+// Local parts of role accounts addressing a function or team rather than a person
+var RoleEmailAccounts = stringSet(
+	"admin administrator root postmaster hostmaster webmaster abuse security noreply no-reply donotreply",
+	"do-not-reply mailer-daemon info support help contact sales billing accounts marketing office team hello",
+	"hr jobs careers privacy legal press media notifications newsletter feedback enquiries inquiries",
+)
+
+// Email address details
+type EmailInfo struct {
+	Domain            string // Domain lowercase ex: mail.example.co.uk
+	RegistrableDomain string // Domain registered under its public suffix ex: example.co.uk
+	PublicSuffix      string // Public suffix of the domain ex: co.uk
+	IsFreeMail        bool   // Denotes if the domain is of a free email provider ex: gmail.com
+	IsDisposable      bool   // Denotes if the domain is of a disposable email provider ex: mailinator.com
+	IsRole            bool   // Denotes if the local part is a role account rather than a person ex: admin, noreply
+}
+
+// Splits the space separated lists into a set.
+func stringSet(lists ...string) map[string]bool {
+	set := make(map[string]bool)
+	for _, list := range lists {
+		for _, s := range strings.Fields(list) {
+			set[s] = true
+		}
+	}
+	return set
+}
+
+// Determines the public suffix of the domain per the Public Suffix List, or empty if the domain is not registered under
+// a known suffix, including a domain that is itself a public suffix ex: co.uk. Internationalized domains are looked up
+// in punycode with the suffix returned as given ex: 中国
+func publicSuffix(domain string) string {
+	domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+	labels := strings.Split(domain, ".")
+	ascii, err := idna.Lookup.ToASCII(domain)
+	if err != nil {
+		return ""
+	}
+	if _, err := publicsuffix.EffectiveTLDPlusOne(ascii); err != nil {
+		return ""
+	}
+	// the default rule returns an unlisted TLD as is, unlike listed private suffixes ex: github.io
+	suffix, icann := publicsuffix.PublicSuffix(ascii)
+	if !icann && !strings.Contains(suffix, ".") {
+		return ""
+	}
+	return strings.Join(labels[len(labels)-strings.Count(suffix, ".")-1:], ".")
+}
+
+// Extracts the details of the email address, where the data has already been inspected as an email address.
+func emailInfo(v string) *EmailInfo {
+	at := strings.LastIndexByte(v, '@')
+	local, domain := strings.ToLower(v[:at]), strings.ToLower(v[at+1:])
+	info := &EmailInfo{Domain: domain, PublicSuffix: publicSuffix(domain)}
+	registrable := strings.TrimSuffix(domain, "."+info.PublicSuffix)
+	info.RegistrableDomain = registrable[strings.LastIndexByte(registrable, '.')+1:] + "." + info.PublicSuffix
+
+	// subaddressing ex: admin+alerts@example.com
+	if plus := strings.IndexByte(local, '+'); plus > 0 {
+		local = local[:plus]
+	}
+	info.IsFreeMail = FreeMailDomains[info.RegistrableDomain] || FreeMailDomains[domain]
+	info.IsDisposable = DisposableEmailDomains[info.RegistrableDomain] || DisposableEmailDomains[domain]
+	info.IsRole = RoleEmailAccounts[local]
+	return info
+}
@@ -0,0 +1,66 @@
+package inspectdata
+
+import (
+	"testing"
+)
+
+func TestInspectEmail(t *testing.T) {
+	emails := map[string]EmailInfo{
+		"bob@mail.example.co.uk":       {"mail.example.co.uk", "example.co.uk", "co.uk", false, false, false},
+		"Alice.Smith@Gmail.com":        {"gmail.com", "gmail.com", "com", true, false, false},
+		"temp123@mailinator.com":       {"mailinator.com", "mailinator.com", "com", false, true, false},
+		"noreply@example.com":          {"example.com", "example.com", "com", false, false, true},
+		"admin+alerts@example.io":      {"example.io", "example.io", "io", false, false, true},
+		"josé@exämple.de":              {"exämple.de", "exämple.de", "de", false, false, false},
+		"用户@例子.中国":                     {"例子.中国", "例子.中国", "中国", false, false, false},
+		"info@xn--exmple-cua.xn--p1ai": {"xn--exmple-cua.xn--p1ai", "xn--exmple-cua.xn--p1ai", "xn--p1ai", false, false, true},
+		"someone@customer.bol.com.br":  {"customer.bol.com.br", "bol.com.br", "com.br", true, false, false},
+		"bob@example.ninja":            {"example.ninja", "example.ninja", "ninja", false, false, false},
+		"x@foo.engineering":            {"foo.engineering", "foo.engineering", "engineering", false, false, false},
+		"a@b.fyi":                      {"b.fyi", "b.fyi", "fyi", false, false, false},
+	}
+	for v, expected := range emails {
+		datum, err := Inspect(v)
+		if err != nil {
+			t.Error(err)
+		}
+		if datum.Canonical != Email || datum.Email == nil || *datum.Email != expected || !datum.IsPII {
+			t.Errorf("Inspect should have detected PII Email %+v for %s, but got: %v %+v", expected, v, datum.Canonical, datum.Email)
+		}
+	}
+
+	// unknown TLD, a public suffix without a registrable label, single label domain, symbols outside the local part character set
+	invalid := []string{
+		"a@b",
+		"bob@example.invalidtld",
+		"bob@co.uk",
+		"x@com.au",
+		"bob@localhost",
+		"€uro@example.com",
+	}
+	for _, v := range invalid {
+		if c, _ := inspectString(v); c == Email {
+			t.Errorf("inspectString should not have detected canonical type Email for %s", v)
+		}
+	}
+}
+
+func TestPublicSuffix(t *testing.T) {
+	suffixes := map[string]string{
+		"example.com":        "com",
+		"www.example.co.uk":  "co.uk",
+		"EXAMPLE.COM.AU":     "com.au",
+		"co.uk":              "",
+		"com.au":             "",
+		"foo.github.io":      "github.io",
+		"例子.中国":              "中国",
+		"example.ninja":      "ninja",
+		"example.notasuffix": "",
+		"com":                "",
+	}
+	for domain, expected := range suffixes {
+		if suffix := publicSuffix(domain); suffix != expected {
+			t.Errorf("publicSuffix should have returned %q for %s, but got: %q", expected, domain, suffix)
+		}
+	}
+}
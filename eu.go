@@ -0,0 +1,200 @@
+package inspectdata
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Regular Expressions for European national identifiers
+const reSpanishDNI = "^[0-9]{8}-?[A-Z]$"
+const reSpanishNIE = "^[XYZ]-?[0-9]{7}-?[A-Z]$"
+const reItalianFiscalCode = "^[A-Z]{6}[0-9LMNPQRSTUV]{2}[ABCDEHLMPRST][0-9LMNPQRSTUV]{2}[A-Z][0-9LMNPQRSTUV]{3}[A-Z]$"
+const reFrenchNIR = "^[12378] ?[0-9]{2} ?(0[1-9]|1[0-2]|[2-9][0-9]) ?([0-9]{2}|2[AB]) ?[0-9]{3} ?[0-9]{3} ?[0-9]{2}$"
+const reGermanTaxID = "^[1-9][0-9] [0-9]{3} [0-9]{3} [0-9]{3}$"
+const reGermanTaxIDBare = "^[1-9][0-9]{10}$"
+const reDutchBSN = `^[0-9]{4}\.[0-9]{2}\.[0-9]{3}$`
+const reDutchBSNBare = "^[0-9]{8,9}$"
+const reBelgianNRN = `^[0-9]{2}\.[0-9]{2}\.[0-9]{2}-[0-9]{3}\.[0-9]{2}$`
+const reBelgianNRNBare = "^[0-9]{11}$"
+const rePolishPESEL = "^[0-9]{11}$"
+const reSwedishPersonnummer = "^([0-9]{2})?[0-9]{6}[-+][0-9]{4}$"
+const reSwedishPersonnummerBare = "^([0-9]{2})?[0-9]{10}$"
+const reFinnishHETU = "^[0-9]{6}[-+ABCDEFYXWVU][0-9]{3}[0-9A-Y]$"
+
+// Spanish DNI/NIE check letters indexed by number mod 23
+const spanishCheckLetters = "TRWAGMYFPDXBNJZSQVHLCKE"
+
+// Finnish HETU check characters indexed by number mod 31
+const finnishCheckChars = "0123456789ABCDEFHJKLMNPRSTUVWXY"
+
+// Italian Codice Fiscale values of characters in odd positions (1st, 3rd, ...) indexed by digit or letter
+var italianOddValues = [36]int{
+	1, 0, 5, 7, 9, 13, 15, 17, 19, 21, // 0-9
+	1, 0, 5, 7, 9, 13, 15, 17, 19, 21, 2, 4, 18, 20, 11, 3, 6, 8, 12, 14, 16, 10, 22, 25, 24, 23, // A-Z
+}
+
+// European national identifiers flagged PII
+var euIdentifiers = []identifier{
+	{SpanishDNI, "ES", regexp.MustCompile(reSpanishDNI), nil, []string{"dni"}, isSpanishDNI, false, false},
+	{SpanishNIE, "ES", regexp.MustCompile(reSpanishNIE), nil, []string{"nie"}, isSpanishDNI, false, false},
+	{ItalianFiscalCode, "IT", regexp.MustCompile(reItalianFiscalCode), nil, []string{"codicefiscale", "cf"}, isItalianFiscalCode, false, false},
+	{FrenchNIR, "FR", regexp.MustCompile(reFrenchNIR), nil, []string{"nir", "insee", "securitesociale"}, isFrenchNIR, false, false},
+	{GermanTaxID, "DE", regexp.MustCompile(reGermanTaxID), regexp.MustCompile(reGermanTaxIDBare), []string{"steuerid", "steueridentifikationsnummer", "idnr"}, isGermanTaxID, false, false},
+	{DutchBSN, "NL", regexp.MustCompile(reDutchBSN), regexp.MustCompile(reDutchBSNBare), []string{"bsn", "burgerservicenummer"}, isDutchBSN, false, false},
+	{BelgianNRN, "BE", regexp.MustCompile(reBelgianNRN), regexp.MustCompile(reBelgianNRNBare), []string{"rijksregisternummer", "registrenational", "nrn", "insz"}, isBelgianNRN, false, false},
+	{PolishPESEL, "PL", nil, regexp.MustCompile(rePolishPESEL), []string{"pesel"}, isPolishPESEL, false, false},
+	{SwedishPersonnummer, "SE", regexp.MustCompile(reSwedishPersonnummer), regexp.MustCompile(reSwedishPersonnummerBare), []string{"personnummer"}, isSwedishPersonnummer, false, false},
+	{FinnishHETU, "FI", regexp.MustCompile(reFinnishHETU), nil, []string{"hetu", "henkilotunnus"}, isFinnishHETU, false, false},
+}
+
+// Validates the check letter of a Spanish DNI (8 digits and letter) or NIE (X, Y or Z, 7 digits and letter).
+func isSpanishDNI(v string) bool {
+	v = removeSeparators(v, "-")
+	digits := v[:len(v)-1]
+	switch digits[0] {
+	case 'X':
+		digits = "0" + digits[1:]
+	case 'Y':
+		digits = "1" + digits[1:]
+	case 'Z':
+		digits = "2" + digits[1:]
+	}
+	n, err := strconv.Atoi(digits)
+	if err != nil {
+		return false
+	}
+	return spanishCheckLetters[n%23] == v[len(v)-1]
+}
+
+// Validates the check character of an Italian Codice Fiscale.
+func isItalianFiscalCode(v string) bool {
+	sum := 0
+	for i := 0; i < 15; i++ {
+		idx := int(v[i] - '0')
+		if v[i] >= 'A' {
+			idx = int(v[i]-'A') + 10
+		}
+		if i%2 == 0 {
+			sum += italianOddValues[idx]
+		} else if idx >= 10 {
+			sum += idx - 10
+		} else {
+			sum += idx
+		}
+	}
+	return v[15] == byte('A'+sum%26)
+}
+
+// Validates the 2 digit key of a French NIR (numéro de sécurité sociale) as 97 minus the first
+// 13 digits mod 97, where the Corsica departments 2A and 2B are substituted with 19 and 18.
+func isFrenchNIR(v string) bool {
+	v = removeSeparators(v, " ")
+	number := strings.NewReplacer("2A", "19", "2B", "18").Replace(v[:13])
+	n, err := strconv.ParseInt(number, 10, 64)
+	if err != nil {
+		return false
+	}
+	key, _ := strconv.Atoi(v[13:])
+	return key == 97-int(n%97)
+}
+
+// Validates a German Steuer-ID (steuerliche Identifikationsnummer): of the first 10 digits exactly
+// one digit occurs two or three times while the others occur at most once, and the check digit
+// is calculated per ISO 7064 MOD 11,10.
+func isGermanTaxID(v string) bool {
+	v = removeSeparators(v, " ")
+	var counts [10]int
+	for i := 0; i < 10; i++ {
+		counts[v[i]-'0']++
+	}
+	repeated := 0
+	for _, c := range counts {
+		if c > 3 {
+			return false
+		}
+		if c > 1 {
+			repeated++
+		}
+	}
+	if repeated != 1 {
+		return false
+	}
+	return iso7064Mod1110(v[:10]) == int(v[10]-'0')
+}
+
+// Validates a Dutch BSN (burgerservicenummer) with the 11-proof: the digits weighted 9 to 2
+// with the last digit weighted -1 must sum to a multiple of 11.
+func isDutchBSN(v string) bool {
+	v = removeSeparators(v, ".")
+	if len(v) == 8 {
+		v = "0" + v
+	}
+	sum := 0
+	for i := 0; i < 8; i++ {
+		sum += int(v[i]-'0') * (9 - i)
+	}
+	sum -= int(v[8] - '0')
+	return sum != 0 && sum%11 == 0
+}
+
+// Validates the check digits of a Belgian national register number (rijksregisternummer) as 97
+// minus the first 9 digits mod 97, prefixed with 2 for those born from 2000 onwards.
+func isBelgianNRN(v string) bool {
+	v = removeSeparators(v, ".-")
+	n, err := strconv.ParseInt(v[:9], 10, 64)
+	if err != nil {
+		return false
+	}
+	check, _ := strconv.Atoi(v[9:])
+	return check == 97-int(n%97) || check == 97-int((2000000000+n)%97)
+}
+
+// Validates a Polish PESEL birth date, where months are offset by 20 per century from 1900
+// (80 for 1800), and its weighted check digit.
+func isPolishPESEL(v string) bool {
+	month, _ := strconv.Atoi(v[2:4])
+	day, _ := strconv.Atoi(v[4:6])
+	if month%20 < 1 || month%20 > 12 || day < 1 || day > 31 {
+		return false
+	}
+	weights := [10]int{1, 3, 7, 9, 1, 3, 7, 9, 1, 3}
+	sum := 0
+	for i, w := range weights {
+		sum += int(v[i]-'0') * w
+	}
+	return (10-sum%10)%10 == int(v[10]-'0')
+}
+
+// Validates a Swedish personnummer (YYMMDD-NNNC or YYYYMMDDNNNC) birth date, where days are offset
+// by 60 for coordination numbers (samordningsnummer), and its Luhn check digit over 10 digits.
+func isSwedishPersonnummer(v string) bool {
+	v = removeSeparators(v, "-+")
+	if len(v) == 12 {
+		v = v[2:]
+	}
+	month, _ := strconv.Atoi(v[2:4])
+	day, _ := strconv.Atoi(v[4:6])
+	if day > 60 {
+		day -= 60
+	}
+	if month < 1 || month > 12 || day < 1 || day > 31 {
+		return false
+	}
+	return luhn(v)
+}
+
+// Validates a Finnish HETU (henkilötunnus) DDMMYYCZZZQ birth date and check character of the
+// 9 digit number DDMMYYZZZ mod 31.
+func isFinnishHETU(v string) bool {
+	day, _ := strconv.Atoi(v[0:2])
+	month, _ := strconv.Atoi(v[2:4])
+	if month < 1 || month > 12 || day < 1 || day > 31 {
+		return false
+	}
+	n, err := strconv.Atoi(v[0:6] + v[7:10])
+	if err != nil {
+		return false
+	}
+	return finnishCheckChars[n%31] == v[10]
+}
@@ -0,0 +1,87 @@
+package inspectdata
+
+import (
+	"testing"
+)
+
+func TestInspectEUIdentifier(t *testing.T) {
+	ids := map[string]CanonicalType{
+		"12345678Z":             SpanishDNI,
+		"12345678-Z":            SpanishDNI,
+		"X1234567L":             SpanishNIE,
+		"RSSMRA85T10A562S":      ItalianFiscalCode,
+		"255081416802538":       FrenchNIR,
+		"2 55 08 14 168 025 38": FrenchNIR,
+		"86 095 742 719":        GermanTaxID,
+		"1112.22.333":           DutchBSN,
+		"85.07.30-033.28":       BelgianNRN,
+		"811228-9874":           SwedishPersonnummer,
+		"19811228-9874":         SwedishPersonnummer,
+		"131052-308T":           FinnishHETU,
+	}
+	for v, expected := range ids {
+		if c, _ := inspectString(v); c != expected {
+			t.Errorf("inspectString should have detected canonical type %v for %s, but got: %v", expected, v, c)
+		}
+	}
+
+	// invalid check digits
+	invalid := []string{"12345678A", "X1234567A", "RSSMRA85T10A562A", "255081416802539", "86 095 742 710",
+		"1112.22.334", "85.07.30-033.29", "811228-9875", "131052-308U"}
+	for _, v := range invalid {
+		if c, _ := inspectString(v); c != Unknown {
+			t.Errorf("inspectString should not have detected canonical type for %s, but got: %v", v, c)
+		}
+	}
+}
+
+func TestInspectEUIdentifierContext(t *testing.T) {
+	bare := []struct {
+		data     string
+		opts     Options
+		expected CanonicalType
+	}{
+		{"111222333", Options{Country: "NL"}, DutchBSN},
+		{"111222333", Options{Field: "bsn"}, DutchBSN},
+		{"86095742719", Options{Country: "de"}, GermanTaxID},
+		{"85073003328", Options{Country: "BE"}, BelgianNRN},
+		{"44051401359", Options{Field: "pesel"}, PolishPESEL},
+		{"8112289874", Options{Country: "SE"}, SwedishPersonnummer},
+	}
+	for _, b := range bare {
+		datum, err := InspectWithOptions(b.data, b.opts)
+		if err != nil {
+			t.Error(err)
+		}
+		if datum.Canonical != b.expected {
+			t.Errorf("InspectWithOptions should have detected %v for %s with %+v, but got: %v", b.expected, b.data, b.opts, datum.Canonical)
+		}
+		if !datum.IsPII || datum.Country == "" {
+			t.Errorf("National identifier %s should be denoted as PII with country", b.data)
+		}
+	}
+
+	// plain digits remain ambiguous without context
+	c, _ := inspectString("111222333")
+	if c != SSN {
+		t.Errorf("inspectString should have detected canonical type SSN without context, but got: %v", c)
+	}
+	datum, _ := InspectWithOptions("44051401358", Options{Country: "PL"})
+	if datum.Canonical == PolishPESEL {
+		t.Errorf("InspectWithOptions should not have detected PolishPESEL with invalid check digit")
+	}
+	datum, _ = InspectWithOptions("44051401359", Options{Country: "NL"})
+	if datum.Canonical == PolishPESEL {
+		t.Errorf("InspectWithOptions should not have detected PolishPESEL for another country")
+	}
+}
+
+func TestInspectEUIdentifierDatum(t *testing.T) {
+	datum, err := Inspect("12345678Z")
+	if err != nil {
+		t.Error(err)
+	}
+	if !datum.IsPII || datum.Country != "ES" {
+		t.Errorf("Spanish DNI should be denoted as PII with country ES, but got: %+v", datum)
+	}
+}
@@ -0,0 +1,275 @@
+package inspectdata
+
+import (
+	"encoding/json"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Precision in meters of geocoordinates, finer than which the location of a person is identified as PII, where
+// coordinates of 3 or more decimal places are PII ex: 47.123, -122.321 while 2 or fewer are not ex: 47.12, -122.32
+var GeoPIIPrecision = 1000.0
+
+// Meters per degree of latitude or of longitude at the equator
+const metersPerDegree = 111320.0
+
+// Regular Expressions for geocoordinates
+// Decimal latitude and longitude separated by a comma or whitespace ex: 47.1231, -122.3213 or 47.1231 -122.3213
+const reDecimalLatLong = `^([-+]?(?:[1-8]?\d(?:\.\d+)?|90(?:\.0+)?))(,\s*|\s+)([-+]?(?:180(?:\.0+)?|(?:1[0-7]\d|[1-9]?\d)(?:\.\d+)?))$`
+
+// Degrees, minutes and seconds with a hemisphere leading or following each coordinate ex: 40°26'46"N 79°58'56"W
+const reDMS = `(\d{1,3}(?:\.\d+)?)\s*°\s*(?:(\d{1,2}(?:\.\d+)?)\s*['′]\s*(?:(\d{1,2}(?:\.\d+)?)\s*(?:"|″|''|”))?)?`
+const reDMSLatLong = `(?i)^(?:([NSEW])\s*` + reDMS + `(?:\s*,\s*|\s+)([NSEW])\s*` + reDMS +
+	`|` + reDMS + `\s*([NSEW])(?:\s*,\s*|\s+)` + reDMS + `\s*([NSEW]))$`
+
+// GeoJSON position array of longitude, latitude and any altitude ex: [-122.3213, 47.1231]
+const reGeoJSONPosition = `^\[\s*([-+]?\d{1,3}(?:\.\d+)?)\s*,\s*([-+]?\d{1,2}(?:\.\d+)?)(?:\s*,\s*[-+]?\d+(?:\.\d+)?)?\s*\]$`
+
+// WKT or EWKT point of longitude, latitude and any altitude or measure ex: POINT(-122.3213 47.1231)
+const reWKTPoint = `(?i)^(?:SRID=\d+;\s*)?POINT\s*(?:ZM|Z|M)?\s*\(\s*([-+]?\d{1,3}(?:\.\d+)?)\s+([-+]?\d{1,2}(?:\.\d+)?)(?:\s+[-+]?\d+(?:\.\d+)?){0,2}\s*\)$`
+
+// Open Location Code (Plus Code) of full length, with any padding ahead of the plus sign ex: 849VCWC8+R9 or 849V0000+
+const rePlusCode = `(?i)^[2-9C][2-9CFGHJMPQRV](?:[2-9CFGHJMPQRVWX]{6}\+(?:[2-9CFGHJMPQRVWX]{2,7})?|[2-9CFGHJMPQRVWX]{4}00\+|[2-9CFGHJMPQRVWX]{2}0000\+|0{6}\+)$`
+
+// Geohash of 7 or more characters, finer than the cells of about 1 km of shorter geohashes ex: 9q8yyk8yu
+const reGeohash = `^[0-9b-hjkmnp-z]{7,12}$`
+
+var validDecimalLatLong = regexp.MustCompile(reDecimalLatLong)
+var validDMSLatLong = regexp.MustCompile(reDMSLatLong)
+var validGeoJSONPosition = regexp.MustCompile(reGeoJSONPosition)
+var validWKTPoint = regexp.MustCompile(reWKTPoint)
+var validPlusCode = regexp.MustCompile(rePlusCode)
+var validGeohash = regexp.MustCompile(reGeohash)
+
+// Alphabets of Plus Codes and geohashes in order of their digit values
+const plusCodeAlphabet = "23456789CFGHJMPQRVWX"
+const geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// Geocoordinates parsed from any notation
+type GeoInfo struct {
+	Latitude  float64 // Latitude in decimal degrees, the center of the cell of a geohash or Plus Code ex: 47.1231
+	Longitude float64 // Longitude in decimal degrees, the center of the cell of a geohash or Plus Code ex: -122.3213
+	Precision float64 // Precision in meters as the larger dimension of the cell given by the notation ex: 11.1
+	Format    string  // Notation of the geocoordinates ex: Decimal, DMS, GeoJSON, WKT, Geohash or PlusCode
+}
+
+// Inspects the string to determine if it is geocoordinates in decimal notation separated by whitespace, degrees
+// minutes and seconds, a GeoJSON point or WKT point, or a Plus Code.
+// Returns Unknown if the string is not such geocoordinates.
+func inspectGeo(v string) CanonicalType {
+	if parseCoordinates(v) != nil {
+		return LatLong
+	} else if parsePlusCode(v) != nil {
+		return PlusCode
+	}
+	return Unknown
+}
+
+// Inspects the string to determine if it is a geohash, where a word with trailing digits is not ex: bestbuy22
+// Returns Unknown if the string is not a geohash.
+func inspectGeohash(v string) CanonicalType {
+	if parseGeohash(v) != nil {
+		return Geohash
+	}
+	return Unknown
+}
+
+// Parses the geocoordinates of the canonical type, where the data has already been inspected as geocoordinates.
+func geoInfo(c CanonicalType, v string) *GeoInfo {
+	switch c {
+	case PlusCode:
+		return parsePlusCode(v)
+	case Geohash:
+		return parseGeohash(v)
+	}
+	return parseCoordinates(v)
+}
+
+// Parses latitude and longitude of any notation, or nil if the string is not geocoordinates.
+func parseCoordinates(v string) *GeoInfo {
+	v = strings.TrimSpace(v)
+	if m := validDecimalLatLong.FindStringSubmatch(v); m != nil {
+		// whitespace separated coordinates are only identified with decimal places ex: 47.1 -122.3 rather than 47 122
+		if strings.TrimSpace(m[2]) == "" && (!strings.Contains(m[1], ".") || !strings.Contains(m[3], ".")) {
+			return nil
+		}
+		return decimalGeoInfo(m[1], m[3], "Decimal")
+	} else if m := validGeoJSONPosition.FindStringSubmatch(v); m != nil {
+		return decimalGeoInfo(m[2], m[1], "GeoJSON")
+	} else if m := validWKTPoint.FindStringSubmatch(v); m != nil {
+		return decimalGeoInfo(m[2], m[1], "WKT")
+	} else if strings.HasPrefix(v, "{") {
+		return parseGeoJSONPoint(v)
+	}
+	return parseDMS(v)
+}
+
+// Parses a GeoJSON point object ex: {"type": "Point", "coordinates": [-122.3213, 47.1231]}
+func parseGeoJSONPoint(v string) *GeoInfo {
+	var point struct {
+		Type        string        `json:"type"`
+		Coordinates []json.Number `json:"coordinates"`
+	}
+	if json.Unmarshal([]byte(v), &point) != nil || point.Type != "Point" || len(point.Coordinates) < 2 || len(point.Coordinates) > 3 {
+		return nil
+	}
+	return decimalGeoInfo(point.Coordinates[1].String(), point.Coordinates[0].String(), "GeoJSON")
+}
+
+// Parses the decimal latitude and longitude, where the precision is given by their decimal places.
+// Returns nil if either is out of range.
+func decimalGeoInfo(lat string, long string, format string) *GeoInfo {
+	latitude, err := strconv.ParseFloat(lat, 64)
+	if err != nil || math.Abs(latitude) > 90 {
+		return nil
+	}
+	longitude, err := strconv.ParseFloat(long, 64)
+	if err != nil || math.Abs(longitude) > 180 {
+		return nil
+	}
+	return &GeoInfo{latitude, longitude, cellMeters(latitude, decimalUnit(lat), decimalUnit(long)), format}
+}
+
+// Parses geocoordinates of degrees, minutes and seconds, or nil if the string is not such geocoordinates.
+func parseDMS(v string) *GeoInfo {
+	m := validDMSLatLong.FindStringSubmatch(v)
+	if m == nil {
+		return nil
+	}
+	// hemispheres lead the coordinates ex: N 40°26'46" W 79°58'56" or follow them ex: 40°26'46"N 79°58'56"W
+	firstHemisphere, first, secondHemisphere, second := m[1], m[2:5], m[5], m[6:9]
+	if firstHemisphere == "" {
+		first, firstHemisphere, second, secondHemisphere = m[9:12], m[12], m[13:16], m[16]
+	}
+	firstHemisphere, secondHemisphere = strings.ToUpper(firstHemisphere), strings.ToUpper(secondHemisphere)
+	// coordinates are ordered by their hemispheres ex: 79°58'56"W 40°26'46"N
+	if strings.ContainsAny(firstHemisphere, "EW") {
+		first, second, firstHemisphere, secondHemisphere = second, first, secondHemisphere, firstHemisphere
+	}
+	if !strings.ContainsAny(firstHemisphere, "NS") || !strings.ContainsAny(secondHemisphere, "EW") {
+		return nil
+	}
+	latitude, latUnit, ok := dmsDegrees(first)
+	if !ok || latitude > 90 {
+		return nil
+	}
+	longitude, longUnit, ok := dmsDegrees(second)
+	if !ok || longitude > 180 {
+		return nil
+	}
+	if firstHemisphere == "S" {
+		latitude = -latitude
+	}
+	if secondHemisphere == "W" {
+		longitude = -longitude
+	}
+	return &GeoInfo{latitude, longitude, cellMeters(latitude, latUnit, longUnit), "DMS"}
+}
+
+// Converts the degrees, minutes and seconds of the coordinate into decimal degrees and the unit in degrees of its
+// least significant part. Returns false if the minutes or seconds are out of range.
+func dmsDegrees(m []string) (float64, float64, bool) {
+	degrees, _ := strconv.ParseFloat(m[0], 64)
+	unit := decimalUnit(m[0])
+	// minutes and seconds are only given for whole degrees and minutes
+	if m[1] != "" {
+		minutes, _ := strconv.ParseFloat(m[1], 64)
+		if strings.Contains(m[0], ".") || minutes >= 60 {
+			return 0, 0, false
+		}
+		degrees += minutes / 60
+		unit = decimalUnit(m[1]) / 60
+	}
+	if m[2] != "" {
+		seconds, _ := strconv.ParseFloat(m[2], 64)
+		if strings.Contains(m[1], ".") || seconds >= 60 {
+			return 0, 0, false
+		}
+		degrees += seconds / 3600
+		unit = decimalUnit(m[2]) / 3600
+	}
+	return degrees, unit, true
+}
+
+// Parses a full Plus Code into the center of its cell, or nil if the string is not a Plus Code.
+// The first 10 digits are pairs of latitude and longitude digits in base 20, and each following digit divides the
+// cell into a grid of 5 rows and 4 columns.
+func parsePlusCode(v string) *GeoInfo {
+	if !validPlusCode.MatchString(v) {
+		return nil
+	}
+	digits := strings.TrimRight(strings.Replace(strings.ToUpper(v), "+", "", 1), "0")
+	latitude, longitude := -90.0, -180.0
+	latCell, longCell := 400.0, 400.0
+	for i, d := range digits {
+		value := float64(strings.IndexRune(plusCodeAlphabet, d))
+		switch {
+		case i < 10 && i%2 == 0:
+			latCell /= 20
+			latitude += value * latCell
+		case i < 10:
+			longCell /= 20
+			longitude += value * longCell
+		default:
+			latCell, longCell = latCell/5, longCell/4
+			latitude += math.Floor(value/4) * latCell
+			longitude += math.Mod(value, 4) * longCell
+		}
+	}
+	latitude, longitude = latitude+latCell/2, longitude+longCell/2
+	return &GeoInfo{latitude, longitude, cellMeters(latitude, latCell, longCell), "PlusCode"}
+}
+
+// Parses a geohash into the center of its cell, or nil if the string is not a geohash.
+// Outside of hexadecimal, a geohash has a letter following a digit, unlike words with trailing digits ex: bestbuy22
+func parseGeohash(v string) *GeoInfo {
+	if !validGeohash.MatchString(v) || !strings.ContainsAny(v, "ghjkmnpqrstuvwxyz") {
+		return nil
+	}
+	digit := strings.IndexAny(v, "0123456789")
+	if digit < 0 || strings.IndexAny(v[digit:], "bcdefghjkmnpqrstuvwxyz") < 0 {
+		return nil
+	}
+	minLat, maxLat, minLong, maxLong := -90.0, 90.0, -180.0, 180.0
+	even := true
+	for _, c := range v {
+		value := strings.IndexRune(geohashAlphabet, c)
+		for bit := 4; bit >= 0; bit-- {
+			set := value&(1<<uint(bit)) != 0
+			// bits alternate between longitude and latitude, halving the cell with each bit
+			if even {
+				if mid := (minLong + maxLong) / 2; set {
+					minLong = mid
+				} else {
+					maxLong = mid
+				}
+			} else {
+				if mid := (minLat + maxLat) / 2; set {
+					minLat = mid
+				} else {
+					maxLat = mid
+				}
+			}
+			even = !even
+		}
+	}
+	latitude, longitude := (minLat+maxLat)/2, (minLong+maxLong)/2
+	return &GeoInfo{latitude, longitude, cellMeters(latitude, maxLat-minLat, maxLong-minLong), "Geohash"}
+}
+
+// Determines the unit in degrees of the least significant decimal place of the number ex: 0.001 for 47.123
+func decimalUnit(v string) float64 {
+	i := strings.IndexByte(v, '.')
+	if i < 0 {
+		return 1
+	}
+	return math.Pow(10, -float64(len(v)-i-1))
+}
+
+// Determines the larger dimension in meters of the cell of the latitude and longitude units in degrees, where a
+// degree of longitude narrows away from the equator.
+func cellMeters(latitude float64, latUnit float64, longUnit float64) float64 {
+	return math.Max(latUnit, longUnit*math.Cos(latitude*math.Pi/180)) * metersPerDegree
+}
@@ -0,0 +1,74 @@
+package inspectdata
+
+import (
+	"math"
+	"testing"
+)
+
+func TestInspectGeo(t *testing.T) {
+	coordinates := map[string]GeoInfo{
+		"47.1231, -122.3213":                                     {47.1231, -122.3213, 11.132, "Decimal"},
+		"37.7749 -122.4194":                                      {37.7749, -122.4194, 11.132, "Decimal"},
+		`40°26'46"N 79°58'56"W`:                                  {40.446111, -79.982222, 30.922, "DMS"},
+		`79° 58.933' W, 40° 26.767' N`:                           {40.446117, -79.982217, 1.855, "DMS"},
+		"S 33.8688° E 151.2093°":                                 {-33.8688, 151.2093, 11.132, "DMS"},
+		"[-122.4194, 37.7749]":                                   {37.7749, -122.4194, 11.132, "GeoJSON"},
+		`{"type": "Point", "coordinates": [-122.4194, 37.7749]}`: {37.7749, -122.4194, 11.132, "GeoJSON"},
+		"POINT(-122.4194 37.7749)":                               {37.7749, -122.4194, 11.132, "WKT"},
+		"SRID=4326;POINT Z (-122.4194 37.7749 12.5)":             {37.7749, -122.4194, 11.132, "WKT"},
+		"u4pruydqqvj":                                            {57.64911, 10.40744, 0.149, "Geohash"},
+		"849VCWC8+R9":                                            {37.4219875, -122.0840625, 13.915, "PlusCode"},
+	}
+	for v, expected := range coordinates {
+		datum, err := Inspect(v)
+		if err != nil {
+			t.Error(err)
+		}
+		geo := datum.Geo
+		if geo == nil || math.Abs(geo.Latitude-expected.Latitude) > 1e-4 || math.Abs(geo.Longitude-expected.Longitude) > 1e-4 ||
+			math.Abs(geo.Precision-expected.Precision) > 1e-3 || geo.Format != expected.Format || !datum.IsPII {
+			t.Errorf("Inspect should have detected PII %v %+v for %q, but got: %v %+v", datum.Canonical, expected, v, datum.Canonical, geo)
+		}
+	}
+	if c, _ := inspectString("u4pruydqqvj"); c != Geohash {
+		t.Errorf("inspectString should have detected canonical type Geohash, but got: %v", c)
+	}
+	if c, _ := inspectString("849vcwc8+r9"); c != PlusCode {
+		t.Errorf("inspectString should have detected canonical type PlusCode, but got: %v", c)
+	}
+
+	// out of range, minutes of 60 or more, hemispheres of the same axis, ordinary numbers and words
+	invalid := []string{
+		"12 34",
+		"47.1 122",
+		`91°00'00"N 10°00'00"E`,
+		`40°61'00"N 79°58'56"W`,
+		`40°26'46"N 79°58'56"N`,
+		`40°26'46" 79°58'56"`,
+		"POINT(200 10)",
+		"[10, 95]",
+		`{"type": "LineString", "coordinates": [[10, 20], [30, 40]]}`,
+		"bestbuy22",
+		"deadbeef12",
+		"849VCWC8R9",
+	}
+	for _, v := range invalid {
+		if c, _ := inspectString(v); c == LatLong || c == Geohash || c == PlusCode {
+			t.Errorf("inspectString should not have detected geocoordinates for %q, but got: %v", v, c)
+		}
+	}
+}
+
+func TestGeoPrecision(t *testing.T) {
+	// coarse coordinates identify a city or region rather than a person
+	for _, v := range []string{"47.12, -122.32", "23,120", "849V0000+", "9q8yyk"} {
+		datum, _ := Inspect(v)
+		if datum.IsPII {
+			t.Errorf("Inspect should not have detected PII for coarse geocoordinates %q with precision %+v", v, datum.Geo)
+		}
+	}
+	datum, _ := Inspect("849V0000+")
+	if datum.Canonical != PlusCode || datum.Geo.Precision < GeoPIIPrecision {
+		t.Errorf("Inspect should have detected a PlusCode coarser than GeoPIIPrecision, but got: %v %+v", datum.Canonical, datum.Geo)
+	}
+}
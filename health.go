@@ -0,0 +1,49 @@
+package inspectdata
+
+import (
+	"regexp"
+)
+
+// Regular Expressions for US healthcare identifiers and diagnosis codes
+// Medicare Beneficiary Identifier letters exclude S, L, O, I, B and Z.
+const reNPI = "^[12][0-9]{9}$"
+const reDEA = "^[ABCDEFGHJKLMPRSTUX][A-Z9][0-9]{7}$"
+const reMBI = "^[1-9][AC-HJKMNP-RT-Y][AC-HJKMNP-RT-Y0-9][0-9]-?[AC-HJKMNP-RT-Y][AC-HJKMNP-RT-Y0-9][0-9]-?[AC-HJKMNP-RT-Y]{2}[0-9]{2}$"
+const reHICN = "^[0-9]{3}-?[0-9]{2}-?[0-9]{4}([ABDEFMT]|[BCD][1-9]|[JK][1-4]|W[1-9]?)$"
+const reICD10 = `^[A-TV-Z][0-9][0-9AB]\.[0-9A-TV-Z]{1,4}$`
+const reICD10Bare = "^[A-TV-Z][0-9][0-9AB][0-9A-TV-Z]{0,4}$"
+
+var validICD10 = regexp.MustCompile(reICD10)
+var validICD10Bare = regexp.MustCompile(reICD10Bare)
+
+// Field name hints indicating an ICD-10 diagnosis code
+var icd10Hints = []string{"icd", "icd10", "icd10cm", "diagnosis", "dx"}
+
+// US healthcare identifiers flagged PII and PHI, where an NPI looks like a US phone number and requires a field name hint
+var healthIdentifiers = []identifier{
+	{NPI, "US", nil, regexp.MustCompile(reNPI), []string{"npi", "provider", "prescriber"}, isNPI, true, true},
+	{DEA, "US", regexp.MustCompile(reDEA), nil, []string{"dea"}, isDEA, true, false},
+	{MBI, "US", regexp.MustCompile(reMBI), nil, []string{"mbi", "medicare"}, noCheckDigit, true, false},
+	{HICN, "US", regexp.MustCompile(reHICN), nil, []string{"hicn", "medicare"}, isHICN, true, false},
+}
+
+// Validates the Luhn check digit of a US National Provider Identifier prefixed with the 80840 health industry number.
+func isNPI(v string) bool {
+	return luhn("80840" + v)
+}
+
+// Validates the check digit of a US DEA registration number: the sum of the 1st, 3rd and 5th digits
+// plus twice the sum of the 2nd, 4th and 6th digits, where the check digit is the last digit of the sum.
+func isDEA(v string) bool {
+	d := v[2:]
+	sum := int(d[0]-'0') + int(d[2]-'0') + int(d[4]-'0') + 2*(int(d[1]-'0')+int(d[3]-'0')+int(d[5]-'0'))
+	return sum%10 == int(d[6]-'0')
+}
+
+// Validates the SSN of a legacy Medicare Health Insurance Claim Number is not of a never issued
+// area (000, 666, 900-999), group (00) or serial (0000).
+func isHICN(v string) bool {
+	v = removeSeparators(v, "-")
+	area, group, serial := v[0:3], v[3:5], v[5:9]
+	return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+}
@@ -0,0 +1,84 @@
+package inspectdata
+
+import (
+	"testing"
+)
+
+func TestInspectHealthIdentifier(t *testing.T) {
+	ids := map[string]CanonicalType{
+		"AB1234563":     DEA,
+		"FS9876547":     DEA,
+		"1EG4-TE5-MK73": MBI,
+		"1EG4TE5MK73":   MBI,
+		"123456789A":    HICN,
+		"123-45-6789B1": HICN,
+	}
+	for v, expected := range ids {
+		datum, err := Inspect(v)
+		if err != nil {
+			t.Error(err)
+		}
+		if datum.Canonical != expected || !datum.IsPHI {
+			t.Errorf("Inspect should have detected PHI %v for %s, but got: %+v", expected, v, datum)
+		}
+	}
+
+	// invalid check digit, excluded MBI letters, never issued SSN area, U codes reserved
+	invalid := map[string]CanonicalType{
+		"AB1234564":   DEA,
+		"1SG4TE5MK73": MBI,
+		"1EG4TE5MO73": MBI,
+		"666456789A":  HICN,
+	}
+	for v, unexpected := range invalid {
+		if c, _ := inspectString(v); c == unexpected {
+			t.Errorf("inspectString should not have detected canonical type %v for %s", unexpected, v)
+		}
+	}
+
+}
+
+func TestInspectHealthIdentifierContext(t *testing.T) {
+	c, _ := inspectString("1234567893")
+	if c == NPI {
+		t.Errorf("inspectString should not have detected canonical type NPI for plain digits without context")
+	}
+
+	datum, err := InspectWithOptions("1234567893", Options{Field: "provider_npi"})
+	if err != nil {
+		t.Error(err)
+	}
+	if datum.Canonical != NPI || !datum.IsPII || !datum.IsPHI || datum.Country != "US" {
+		t.Errorf("InspectWithOptions should have detected PII and PHI NPI, but got: %+v", datum)
+	}
+	datum, _ = InspectWithOptions("1234567890", Options{Field: "npi"})
+	if datum.Canonical == NPI {
+		t.Errorf("InspectWithOptions should not have detected NPI with invalid check digit")
+	}
+
+	// a valid NPI looks like a US phone number, so the country alone does not suffice
+	datum, _ = InspectWithOptions("1234567893", Options{Country: "US"})
+	if datum.Canonical == NPI || datum.IsPHI {
+		t.Errorf("InspectWithOptions should not have detected NPI from the country alone, but got: %+v", datum)
+	}
+	datum, _ = InspectWithOptions("1234567893", Options{Field: "referring_provider"})
+	if datum.Canonical != NPI {
+		t.Errorf("InspectWithOptions should have detected NPI from provider field hint, but got: %+v", datum)
+	}
+
+	// ICD-10 codes with or without the dot, only from a field hint
+	for _, v := range []string{"E11.9", "S72.001A", "B12.5", "E119"} {
+		datum, _ = InspectWithOptions(v, Options{Field: "diagnosis_code"})
+		if datum.Canonical != ICD10 || !datum.IsPHI || datum.IsPII {
+			t.Errorf("InspectWithOptions should have detected PHI ICD10 %s from field hint, but got: %+v", v, datum)
+		}
+		if c, _ := inspectString(v); c == ICD10 {
+			t.Errorf("inspectString should not have detected canonical type ICD10 for %s without context", v)
+		}
+	}
+
+	datum, _ = InspectWithOptions("U07.1", Options{Field: "icd10"})
+	if datum.Canonical == ICD10 {
+		t.Errorf("InspectWithOptions should not have detected canonical type ICD10 for a reserved U code")
+	}
+}
@@ -0,0 +1,94 @@
+package inspectdata
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Identifier issued by a country or sector (ex: national ID, tax ID) validated by its check digits.
+// Identifiers with a distinctive layout are identified from the data alone, while ambiguous layouts
+// such as plain digits that could also be a SSN or phone number require context from the inspect
+// options: the identifier's country or a field name hint.
+type identifier struct {
+	canonical CanonicalType
+	country   string            // Issuing country ISO ALPHA-2 Code ex: ES
+	format    *regexp.Regexp    // Distinctive layout identified without context, nil if none
+	bare      *regexp.Regexp    // Ambiguous layout only identified with context, nil if none
+	hints     []string          // Field name hints indicating the identifier
+	valid     func(string) bool // Validates the check digits of data matching format or bare
+	phi       bool              // Denotes if considered Protected Health Information
+	hintOnly  bool              // Denotes if the ambiguous layout requires a field name hint rather than the country alone
+}
+
+// Registered identifiers in order of precedence when data is valid for more than one
+var identifiers = concatIdentifiers(euIdentifiers, ukIdentifiers, latamIdentifiers, apacIdentifiers, caIdentifiers, healthIdentifiers)
+
+// Concatenates identifier lists maintaining their order of precedence.
+func concatIdentifiers(lists ...[]identifier) []identifier {
+	var all []identifier
+	for _, list := range lists {
+		all = append(all, list...)
+	}
+	return all
+}
+
+// Finds the registered identifier for the canonical type.
+func lookupIdentifier(c CanonicalType) (identifier, bool) {
+	for _, id := range identifiers {
+		if id.canonical == c {
+			return id, true
+		}
+	}
+	return identifier{}, false
+}
+
+// Inspects the string to determine if it is an identifier with a distinctive layout and valid check digits.
+// Returns Unknown if the string is not such an identifier.
+func inspectIdentifier(v string) CanonicalType {
+	for _, id := range identifiers {
+		if id.format != nil && id.format.MatchString(v) && id.valid(v) {
+			return id.canonical
+		}
+	}
+	return Unknown
+}
+
+// Inspects the string using the country or field name of the options to determine if it is an
+// identifier in either its distinctive or ambiguous layout with valid check digits.
+// Returns Unknown if the options do not indicate an identifier for the data.
+func inspectIdentifierContext(v string, opts Options) CanonicalType {
+	for _, id := range identifiers {
+		if (opts.Country == "" || !strings.EqualFold(id.country, opts.Country) || id.hintOnly) && !hasFieldHint(opts.Field, id.hints...) {
+			continue
+		}
+		if ((id.format != nil && id.format.MatchString(v)) || (id.bare != nil && id.bare.MatchString(v))) && id.valid(v) {
+			return id.canonical
+		}
+	}
+	return Unknown
+}
+
+// Accepts data matching the layout of an identifier that has no check digits (ex: India PAN).
+func noCheckDigit(v string) bool {
+	return true
+}
+
+// Removes all separator characters (ex: spaces, dashes, dots) from the string.
+func removeSeparators(v string, separators string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(separators, r) {
+			return -1
+		}
+		return r
+	}, v)
+}
+
+// Finds the index of the rune within the string counted in runes rather than bytes, or -1 if not present.
+func runeIndex(s string, r rune) int {
+	for i, c := range []rune(s) {
+		if c == r {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,36 @@
+package inspectdata
+
+import (
+	"testing"
+)
+
+func TestLookupIdentifier(t *testing.T) {
+	id, ok := lookupIdentifier(DutchBSN)
+	if !ok || id.country != "NL" {
+		t.Errorf("lookupIdentifier should have found DutchBSN for country NL")
+	}
+	if _, ok := lookupIdentifier(Email); ok {
+		t.Errorf("lookupIdentifier should not have found identifier for Email")
+	}
+}
+
+func TestRemoveSeparators(t *testing.T) {
+	if v := removeSeparators("85.07.30-033.28", ".-"); v != "85073003328" {
+		t.Errorf("removeSeparators unexpected result %s", v)
+	}
+}
+
+func TestISO7064Mod1110(t *testing.T) {
+	if c := iso7064Mod1110("8609574271"); c != 9 {
+		t.Errorf("iso7064Mod1110 should have calculated check digit 9, but got: %d", c)
+	}
+}
+
+func TestRuneIndex(t *testing.T) {
+	if i := runeIndex("MNÑOP", 'O'); i != 3 {
+		t.Errorf("runeIndex should have counted runes after multibyte Ñ, but got: %d", i)
+	}
+	if i := runeIndex("ABC", 'Z'); i != -1 {
+		t.Errorf("runeIndex should not have found Z, but got: %d", i)
+	}
+}
@@ -0,0 +1,86 @@
+package inspectdata
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Regular Expressions for unique identifier formats, where UUIDs and ObjectIDs are matched lowercase and ULIDs uppercase
+// UUIDs are of versions 1 to 8 of the RFC 9562 variant, excluding the nil and max UUIDs
+const reUUID = "^[0-9a-f]{8}-[0-9a-f]{4}-[1-8][0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"
+const reULID = "^[0-7][0-9A-HJKMNP-TV-Z]{25}$"
+const reObjectID = "^[0-9a-f]{24}$"
+const reKSUID = "^[0-9A-Za-z]{27}$"
+const reSnowflake = "^[1-9][0-9]{16,18}$"
+const reNanoID = "^[A-Za-z0-9_-]{21}$"
+
+var validGenericUUID = regexp.MustCompile(reUUID)
+var validULID = regexp.MustCompile(reULID)
+var validObjectID = regexp.MustCompile(reObjectID)
+var validKSUID = regexp.MustCompile(reKSUID)
+var validSnowflake = regexp.MustCompile(reSnowflake)
+var validNanoID = regexp.MustCompile(reNanoID)
+
+// Largest KSUID as base62 of 160 bits, where base62 digits sort in the same order as their ASCII characters
+const maxKSUID = "aWgEPTl1tmebfsQzFP4bxwgy80V"
+
+// Field name hints indicating identifier formats only identified with context
+var ksuidHints = []string{"ksuid"}
+var snowflakeHints = []string{"snowflake", "tweetid", "discordid"}
+var nanoIDHints = []string{"nanoid"}
+
+// Denotes which unique identifier formats are considered personal identifiers (PII) when inspected,
+// ex: set PersonalIDFormats[ObjectID] = false when database IDs in your data do not link to a person.
+var PersonalIDFormats = map[CanonicalType]bool{
+	UUIDv4:    true,
+	UUID:      true,
+	ULID:      true,
+	KSUID:     true,
+	ObjectID:  true,
+	Snowflake: true,
+	NanoID:    true,
+}
+
+// UUID version and variant per RFC 9562
+type UUIDInfo struct {
+	Version int    // Version 1 to 8 of the RFC 9562 variant ex: 4 random, 7 Unix time ordered
+	Variant string // Variant of the layout, RFC 9562 for all identified UUIDs
+}
+
+// Inspects the string to determine if it is a UUID of any version, ULID or MongoDB ObjectID distinctive from the data alone.
+// Returns Unknown if the string is not such an identifier.
+func inspectID(v string) CanonicalType {
+	switch {
+	case validGenericUUID.MatchString(strings.ToLower(v)):
+		return UUID
+	case validULID.MatchString(strings.ToUpper(v)) && !isDigits(v):
+		// all digits are a zero padded number rather than a ULID ex: an account or order number
+		return ULID
+	case validObjectID.MatchString(v):
+		return ObjectID
+	}
+	return Unknown
+}
+
+// Inspects the string using the field name of the options to determine if it is a KSUID, Snowflake ID or NanoID.
+// Returns Unknown if the options do not indicate such an identifier for the data.
+func inspectIDContext(v string, opts Options) CanonicalType {
+	switch {
+	case hasFieldHint(opts.Field, ksuidHints...) && validKSUID.MatchString(v) && v <= maxKSUID:
+		return KSUID
+	case hasFieldHint(opts.Field, snowflakeHints...) && validSnowflake.MatchString(v):
+		if _, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return Snowflake
+		}
+	case hasFieldHint(opts.Field, nanoIDHints...) && validNanoID.MatchString(v):
+		return NanoID
+	}
+	return Unknown
+}
+
+// Decodes the version of the UUID from its version nibble, where the data has already been inspected as a UUID.
+func uuidInfo(v string) *UUIDInfo {
+	version, _ := strconv.ParseUint(v[14:15], 16, 8)
+	return &UUIDInfo{Version: int(version), Variant: "RFC 9562"}
+}
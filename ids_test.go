@@ -0,0 +1,111 @@
+package inspectdata
+
+import (
+	"testing"
+)
+
+func TestInspectUUIDVersions(t *testing.T) {
+	uuids := map[string]UUIDInfo{
+		"c232ab00-9414-11ec-b3c8-9f6bdeced846": {1, "RFC 9562"},
+		"000003e8-9414-21ec-8a00-9f6bdeced846": {2, "RFC 9562"},
+		"5df41881-3aed-3515-88a7-2f4a814cf09e": {3, "RFC 9562"},
+		"919108f7-52d1-4320-9bac-f847db4148a8": {4, "RFC 9562"},
+		"2ed6657d-e927-568b-95e1-2665a8aea6a2": {5, "RFC 9562"},
+		"1EC9414C-232A-6B00-B3C8-9F6BDECED846": {6, "RFC 9562"},
+		"017f22e2-79b0-7cc3-98c4-dc0c0c07398f": {7, "RFC 9562"},
+		"2489e9ad-2ee2-8e00-8ec9-32d5f69181c0": {8, "RFC 9562"},
+	}
+	for v, expected := range uuids {
+		datum, err := Inspect(v)
+		if err != nil {
+			t.Error(err)
+		}
+		if datum.UUID == nil || *datum.UUID != expected || !datum.IsPII {
+			t.Errorf("Inspect should have detected PII UUID %+v for %s, but got: %+v", expected, v, datum)
+		}
+	}
+
+	// version 4 with b to e in the second group previously missed
+	c, _ := inspectString("919108f7-52d1-4320-9bac-f847db4148a8")
+	if c != UUIDv4 {
+		t.Errorf("inspectString should have detected canonical type UUIDv4, but got: %v", c)
+	}
+
+	// nil and max UUIDs, versions 0 and 9 to f, and variants other than RFC 9562
+	invalid := []string{
+		"00000000-0000-0000-0000-000000000000",
+		"ffffffff-ffff-ffff-ffff-ffffffffffff",
+		"FFFFFFFF-FFFF-FFFF-FFFF-FFFFFFFFFFFF",
+		"0c76e8e8-b81d-f1e8-9a4c-2f4a814cf09e",
+		"0c76e8e8-b81d-01e8-9a4c-2f4a814cf09e",
+		"0c76e8e8-b81d-91e8-9a4c-2f4a814cf09e",
+		"919108f7-52d1-4320-cbac-f847db4148a8",
+		"3f2504e0-4f89-11d3-7433-0800200c9a66",
+	}
+	for _, v := range invalid {
+		if c, _ := inspectString(v); c == UUID || c == UUIDv4 {
+			t.Errorf("inspectString should not have detected a UUID for %s, but got: %v", v, c)
+		}
+	}
+}
+
+func TestInspectID(t *testing.T) {
+	ids := map[string]CanonicalType{
+		"01ARZ3NDEKTSV4RRFFQ69G5FAV": ULID,
+		"01arz3ndektsv4rrffq69g5fav": ULID,
+		"507f1f77bcf86cd799439011":   ObjectID,
+	}
+	for v, expected := range ids {
+		if c, _ := inspectString(v); c != expected {
+			t.Errorf("inspectString should have detected canonical type %v for %s, but got: %v", expected, v, c)
+		}
+	}
+
+	// ULID timestamps never exceed 7, excluded I, L, O and U, and zero padded numbers of all digits
+	invalid := map[string]CanonicalType{
+		"81ARZ3NDEKTSV4RRFFQ69G5FAV": ULID,
+		"01ARZ3NDEKTSV4RRFFQ69G5FAU": ULID,
+		"00000000000000000000000000": ULID,
+		"00000000000000000012345678": ULID,
+		"507f1f77bcf86cd79943901":    ObjectID,
+	}
+	for v, unexpected := range invalid {
+		if c, _ := inspectString(v); c == unexpected {
+			t.Errorf("inspectString should not have detected canonical type %v for %s", unexpected, v)
+		}
+	}
+}
+
+func TestInspectIDContext(t *testing.T) {
+	contexts := []struct {
+		data     string
+		opts     Options
+		expected CanonicalType
+	}{
+		{"0ujtsYcgvSTl8PAuAdqWYSMnLOv", Options{Field: "ksuid"}, KSUID},
+		{"zzzzzzzzzzzzzzzzzzzzzzzzzzz", Options{Field: "ksuid"}, Unknown},
+		{"1541815603606036480", Options{Field: "snowflake_id"}, Snowflake},
+		{"9999999999999999999", Options{Field: "snowflake_id"}, Unknown},
+		{"V1StGXR8_Z5jdHi6B-myT", Options{Field: "nanoid"}, NanoID},
+		{"V1StGXR8_Z5jdHi6B-myT", Options{Field: "token"}, Unknown},
+	}
+	for _, ctx := range contexts {
+		if c := inspectContext(ctx.data, ctx.opts); c != ctx.expected {
+			t.Errorf("inspectContext should have detected %v for %s with %+v, but got: %v", ctx.expected, ctx.data, ctx.opts, c)
+		}
+	}
+}
+
+func TestPersonalIDFormats(t *testing.T) {
+	defer func() { PersonalIDFormats[ObjectID] = true }()
+
+	PersonalIDFormats[ObjectID] = false
+	datum, _ := Inspect("507f1f77bcf86cd799439011")
+	if datum.Canonical != ObjectID || datum.IsPII {
+		t.Errorf("Inspect should not have denoted ObjectID as PII when configured, but got: %+v", datum)
+	}
+	datum, _ = Inspect("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	if !datum.IsPII {
+		t.Errorf("Inspect should have denoted ULID as PII, but got: %+v", datum)
+	}
+}
@@ -119,8 +119,8 @@ func TestInspectString(t *testing.T) {
 		t.Errorf("inspectString should have detected canonical type UUIDv4, but got: %v", c)
 	}
 	c, _ = inspectString("0c76e8e8-b81d-11e8-96f8-529269fb1459")
-	if c != Unknown {
-		t.Errorf("inspectString should have detected canonical type unknown, but got: %v", c)
+	if c != UUID {
+		t.Errorf("inspectString should have detected canonical type UUID for version 1, but got: %v", c)
 	}
 
 	// validate IPv4
@@ -189,8 +189,8 @@ func TestInspectString(t *testing.T) {
 		t.Errorf("inspectString should have detected canonical type email, but got: %v", c)
 	}
 	c, _ = inspectString("bob@mail")
-	if c != Email {
-		t.Errorf("inspectString should have detected canonical type email, but got: %v", c)
+	if c == Email {
+		t.Errorf("inspectString should not have detected canonical type email without a public suffix")
 	}
 	c, _ = inspectString("bob@mail-foo.com")
 	if c != Email {
@@ -518,7 +518,7 @@ func TestInspect(t *testing.T) {
 	input = "4444444444444448"
 	datum, err = Inspect(input)
 	if err != nil {
-		t.Errorf(err.Error())
+		t.Error(err)
 	}
 	if !datum.IsPCI {
 		t.Errorf("VISA credit card number data should be denoted as PCI")
@@ -533,7 +533,7 @@ func TestInspect(t *testing.T) {
 	input = "bob@mail.com"
 	datum, err = Inspect(input)
 	if err != nil {
-		t.Errorf(err.Error())
+		t.Error(err)
 	}
 	if !datum.IsPII {
 		t.Errorf("Email data should be denoted as PII")
@@ -546,7 +546,7 @@ func TestInspect(t *testing.T) {
 	input = "20180914"
 	datum, err = Inspect(input)
 	if err != nil {
-		t.Errorf(err.Error())
+		t.Error(err)
 	}
 	if datum.IsPII {
 		t.Errorf("CCYYMMDD data should not be denoted as PII")
@@ -559,7 +559,7 @@ func TestInspect(t *testing.T) {
 	input = "}++zZYMUptu`IIpeoQ-n"
 	datum, err = Inspect(input)
 	if err != nil {
-		t.Errorf(err.Error())
+		t.Error(err)
 	}
 	if datum.Canonical != Secret {
 		t.Errorf("Unexpected canonical type %v for %s", datum.Canonical, input)
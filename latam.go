@@ -0,0 +1,142 @@
+package inspectdata
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Regular Expressions for Latin American tax and identity numbers
+const reBrazilianCPF = `^[0-9]{3}\.?[0-9]{3}\.?[0-9]{3}-?[0-9]{2}$`
+const reBrazilianCNPJ = `^[0-9]{2}\.?[0-9]{3}\.?[0-9]{3}/?[0-9]{4}-?[0-9]{2}$`
+const reMexicanCURP = "^[A-Z][AEIOUX][A-Z]{2}[0-9]{2}(0[1-9]|1[0-2])(0[1-9]|[12][0-9]|3[01])[HMX](AS|BC|BS|CC|CL|CM|CS|CH|DF|DG|GT|GR|HG|JC|MC|MN|MS|NT|NL|OC|PL|QT|QR|SP|SL|SR|TC|TS|TL|VZ|YN|ZS|NE)[B-DF-HJ-NP-TV-Z]{3}[0-9A-Z][0-9]$"
+const reMexicanRFC = "^[A-ZÑ&]{3,4}[0-9]{2}(0[1-9]|1[0-2])(0[1-9]|[12][0-9]|3[01])[A-Z0-9]{2}[0-9A]$"
+const reArgentineCUIT = "^(20|23|24|27|30|33|34)-?[0-9]{8}-?[0-9]$"
+const reChileanRUT = `^([0-9]{1,2}\.?[0-9]{3}\.?[0-9]{3}-[0-9Kk]|[0-9]{7,8}[Kk])$`
+const reChileanRUTBare = "^[0-9]{8,9}$"
+
+// Mexican CURP character values for the check digit
+const curpValues = "0123456789ABCDEFGHIJKLMNÑOPQRSTUVWXYZ"
+
+// Mexican RFC character values for the check digit, where space pads the 12 character RFC of companies
+const rfcValues = "0123456789ABCDEFGHIJKLMN&OPQRSTUVWXYZ Ñ"
+
+// Latin American tax and identity numbers flagged PII
+var latamIdentifiers = []identifier{
+	{BrazilianCPF, "BR", regexp.MustCompile(reBrazilianCPF), nil, []string{"cpf"}, isBrazilianCPF, false, false},
+	{BrazilianCNPJ, "BR", regexp.MustCompile(reBrazilianCNPJ), nil, []string{"cnpj"}, isBrazilianCNPJ, false, false},
+	{MexicanCURP, "MX", regexp.MustCompile(reMexicanCURP), nil, []string{"curp"}, isMexicanCURP, false, false},
+	{MexicanRFC, "MX", regexp.MustCompile(reMexicanRFC), nil, []string{"rfc"}, isMexicanRFC, false, false},
+	{ArgentineCUIT, "AR", regexp.MustCompile(reArgentineCUIT), nil, []string{"cuit", "cuil"}, isArgentineCUIT, false, false},
+	{ChileanRUT, "CL", regexp.MustCompile(reChileanRUT), regexp.MustCompile(reChileanRUTBare), []string{"rut", "run"}, isChileanRUT, false, false},
+}
+
+// Calculates a Brazilian check digit as the digits weighted from the given starting weight
+// descending to 2, mod 11 where remainders below 2 become 0 otherwise 11 minus the remainder.
+func brazilianCheckDigit(digits string, weights []int) int {
+	sum := 0
+	for i, w := range weights {
+		sum += int(digits[i]-'0') * w
+	}
+	r := sum % 11
+	if r < 2 {
+		return 0
+	}
+	return 11 - r
+}
+
+// Determines if every digit of the string is the same, ex: 111.111.111-11 which passes check digits but is never issued.
+func isRepeatedDigit(digits string) bool {
+	return strings.Count(digits, digits[:1]) == len(digits)
+}
+
+// Validates both check digits of a Brazilian CPF (Cadastro de Pessoas Físicas).
+func isBrazilianCPF(v string) bool {
+	v = removeSeparators(v, ".-")
+	if isRepeatedDigit(v) {
+		return false
+	}
+	return brazilianCheckDigit(v, []int{10, 9, 8, 7, 6, 5, 4, 3, 2}) == int(v[9]-'0') &&
+		brazilianCheckDigit(v, []int{11, 10, 9, 8, 7, 6, 5, 4, 3, 2}) == int(v[10]-'0')
+}
+
+// Validates both check digits of a Brazilian CNPJ (Cadastro Nacional da Pessoa Jurídica).
+func isBrazilianCNPJ(v string) bool {
+	v = removeSeparators(v, "./-")
+	if isRepeatedDigit(v) {
+		return false
+	}
+	return brazilianCheckDigit(v, []int{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}) == int(v[12]-'0') &&
+		brazilianCheckDigit(v, []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}) == int(v[13]-'0')
+}
+
+// Validates the check digit of a Mexican CURP (Clave Única de Registro de Población): the first
+// 17 characters weighted 18 to 2, where the check digit is 10 minus the sum mod 10.
+func isMexicanCURP(v string) bool {
+	sum := 0
+	for i, r := range []rune(v)[:17] {
+		sum += runeIndex(curpValues, r) * (18 - i)
+	}
+	return (10-sum%10)%10 == int(v[17]-'0')
+}
+
+// Validates the check character of a Mexican RFC (Registro Federal de Contribuyentes): the first
+// 12 characters (companies padded with a leading space) weighted 13 to 2, where the check is 11
+// minus the sum mod 11 with 11 as 0 and 10 as A.
+func isMexicanRFC(v string) bool {
+	chars := []rune(v)
+	if len(chars) == 12 {
+		chars = append([]rune{' '}, chars...)
+	}
+	sum := 0
+	for i, r := range chars[:12] {
+		sum += runeIndex(rfcValues, r) * (13 - i)
+	}
+	check := '0' + rune(11-sum%11)
+	switch sum % 11 {
+	case 0:
+		check = '0'
+	case 1:
+		check = 'A'
+	}
+	return check == chars[12]
+}
+
+// Validates the check digit of an Argentine CUIT/CUIL: the first 10 digits weighted 5 to 2 then 7 to 2,
+// where the check digit is 11 minus the sum mod 11 with 11 as 0 and 10 never issued.
+func isArgentineCUIT(v string) bool {
+	v = removeSeparators(v, "-")
+	weights := [10]int{5, 4, 3, 2, 7, 6, 5, 4, 3, 2}
+	sum := 0
+	for i, w := range weights {
+		sum += int(v[i]-'0') * w
+	}
+	check := 11 - sum%11
+	if check == 11 {
+		check = 0
+	}
+	return check != 10 && check == int(v[10]-'0')
+}
+
+// Validates the check character of a Chilean RUT/RUN: the body digits weighted 2 to 7 repeating from
+// the rightmost digit, where the check is 11 minus the sum mod 11 with 11 as 0 and 10 as K.
+func isChileanRUT(v string) bool {
+	v = strings.ToUpper(removeSeparators(v, ".-"))
+	body := v[:len(v)-1]
+	sum := 0
+	weight := 2
+	for i := len(body) - 1; i >= 0; i-- {
+		sum += int(body[i]-'0') * weight
+		weight++
+		if weight > 7 {
+			weight = 2
+		}
+	}
+	check := byte('0' + 11 - sum%11)
+	switch sum % 11 {
+	case 0:
+		check = '0'
+	case 1:
+		check = 'K'
+	}
+	return check == v[len(v)-1]
+}
@@ -0,0 +1,56 @@
+package inspectdata
+
+import (
+	"testing"
+)
+
+func TestInspectLatAmIdentifier(t *testing.T) {
+	ids := map[string]CanonicalType{
+		"529.982.247-25":     BrazilianCPF,
+		"52998224725":        BrazilianCPF,
+		"11.222.333/0001-81": BrazilianCNPJ,
+		"11222333000181":     BrazilianCNPJ,
+		"HEGG560427MVZRRL04": MexicanCURP,
+		"GODE561231GR8":      MexicanRFC,
+		"20-12345678-6":      ArgentineCUIT,
+		"20267565393":        ArgentineCUIT,
+		"12.345.678-5":       ChileanRUT,
+		"12345678-5":         ChileanRUT,
+		"10000013K":          ChileanRUT,
+		"1.000.005-k":        ChileanRUT,
+	}
+	for v, expected := range ids {
+		if c, _ := inspectString(v); c != expected {
+			t.Errorf("inspectString should have detected canonical type %v for %s, but got: %v", expected, v, c)
+		}
+	}
+
+	// invalid check digits or never issued repeated digits
+	invalid := []string{"529.982.247-26", "111.111.111-11", "11.222.333/0001-82", "HEGG560427MVZRRL05",
+		"GODE561231GR9", "20-12345678-7", "12.345.678-K"}
+	for _, v := range invalid {
+		if c, _ := inspectString(v); c != Unknown {
+			t.Errorf("inspectString should not have detected canonical type for %s, but got: %v", v, c)
+		}
+	}
+}
+
+func TestInspectLatAmIdentifierContext(t *testing.T) {
+	c, _ := inspectString("123456785")
+	if c != SSN {
+		t.Errorf("inspectString should have detected canonical type SSN for plain RUT without context, but got: %v", c)
+	}
+
+	datum, err := InspectWithOptions("123456785", Options{Country: "CL"})
+	if err != nil {
+		t.Error(err)
+	}
+	if datum.Canonical != ChileanRUT || !datum.IsPII || datum.Country != "CL" {
+		t.Errorf("InspectWithOptions should have detected PII ChileanRUT for CL, but got: %+v", datum)
+	}
+
+	datum, _ = InspectWithOptions("52998224725", Options{Field: "cpf"})
+	if datum.Canonical != BrazilianCPF || datum.Country != "BR" {
+		t.Errorf("InspectWithOptions should have detected BrazilianCPF for BR, but got: %+v", datum)
+	}
+}
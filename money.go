@@ -0,0 +1,143 @@
+package inspectdata
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Regular Expression for currency amounts with a currency symbol or ISO 4217 code before or after the amount
+// ex: €1.234,56 or 1 234,56 EUR or -R$ 10,00
+const reMoney = `^(?P<sign>[+-])?(?P<prefix>[A-Z]{3}|[A-Z]{1,3}\$|CN¥|[^\s\d+-]{1,2}\.?)?\s?(?P<sign2>[+-])?` +
+	`(?P<amount>\d(?:[\d.,' \x{00A0}\x{202F}]*\d)?)\s?(?P<suffix>[A-Z]{3}|[^\s\d]{1,3}\.?)?$`
+
+var validMoney = regexp.MustCompile(reMoney)
+
+// Currency symbols and their ISO 4217 codes, where $, ¥ and kr are the dollar, yen and krona of the largest economy
+var currencySymbols = map[string]string{
+	"$": "USD", "US$": "USD", "C$": "CAD", "CA$": "CAD", "A$": "AUD", "AU$": "AUD", "NZ$": "NZD", "HK$": "HKD",
+	"S$": "SGD", "MX$": "MXN", "R$": "BRL", "€": "EUR", "£": "GBP", "¥": "JPY", "CN¥": "CNY", "₹": "INR", "₩": "KRW",
+	"₽": "RUB", "₺": "TRY", "₪": "ILS", "₫": "VND", "₱": "PHP", "฿": "THB", "₦": "NGN", "zł": "PLN", "kr": "SEK",
+	"Kč": "CZK", "Ft": "HUF", "Fr.": "CHF", "lei": "RON",
+}
+
+// ISO 4217 currency codes of the most traded currencies
+var currencyCodes = stringSet(
+	"USD EUR GBP JPY CNY INR BRL CAD AUD NZD CHF SEK NOK DKK PLN CZK HUF RON BGN RUB UAH TRY ZAR MXN ARS CLP COP PEN",
+	"KRW SGD HKD TWD THB IDR MYR PHP VND ILS AED SAR QAR KWD EGP NGN KES MAD PKR BDT ISK RMB",
+)
+
+// Currency amount with its amount normalized to a decimal ex: -1234.56
+type MoneyInfo struct {
+	Amount   string // Decimal amount with any sign, without grouping and with a period decimal separator ex: 1234.56
+	Currency string // ISO 4217 currency code ex: EUR
+}
+
+// Inspects the string to determine if it is a currency amount with a currency symbol or ISO 4217 code in prefix or
+// suffix position, and grouping and decimal separators of any locale ex: €1.234,56 or 1,234.56 GBP
+// Returns Unknown if the string is not such an amount.
+func inspectMoney(v string) CanonicalType {
+	if moneyInfo(v) != nil {
+		return Money
+	}
+	return Unknown
+}
+
+// Parses the currency amount, or nil if the string is not an amount with a currency.
+func moneyInfo(v string) *MoneyInfo {
+	m := validMoney.FindStringSubmatch(v)
+	if m == nil {
+		return nil
+	}
+	group := func(name string) string {
+		return m[validMoney.SubexpIndex(name)]
+	}
+	prefix, suffix := group("prefix"), group("suffix")
+	if (prefix == "") == (suffix == "") || (group("sign") != "" && group("sign2") != "") {
+		return nil
+	}
+	currency, ok := currencyCode(prefix + suffix)
+	if !ok {
+		return nil
+	}
+	amount, ok := parseAmount(group("amount"))
+	if !ok {
+		return nil
+	}
+	if sign := group("sign") + group("sign2"); sign == "-" {
+		amount = sign + amount
+	}
+	return &MoneyInfo{Amount: amount, Currency: currency}
+}
+
+// Parses the amount of US currency, where the data has already been inspected as USD ex: $ -1,234.56
+func usdInfo(v string) *MoneyInfo {
+	v = removeSeparators(v, "$ ")
+	sign := ""
+	if strings.HasPrefix(v, "-") || strings.HasPrefix(v, "+") {
+		sign, v = strings.Trim(v[:1], "+"), v[1:]
+	}
+	amount, _ := parseAmount(v)
+	return &MoneyInfo{Amount: sign + amount, Currency: "USD"}
+}
+
+// Determines the ISO 4217 code of the currency symbol or code, where RMB is the Chinese yuan.
+func currencyCode(v string) (string, bool) {
+	if code, ok := currencySymbols[v]; ok {
+		return code, true
+	}
+	if v == "RMB" {
+		return "CNY", true
+	}
+	return v, currencyCodes[v]
+}
+
+// Parses the amount with grouping and decimal separators of any locale into a decimal with a period decimal separator.
+// Spaces and apostrophes are always grouping ex: 1 234,56 or 1'234.56. Where both a period and comma are given the
+// latter is the decimal separator ex: 1.234,56 while a single separator followed by exactly three digits is grouping
+// ex: 1,234 or 1.234, otherwise decimal ex: 12,5
+// Groups following the first are three digits, or two digits ahead of the last in Indian grouping ex: 1,23,456.78
+func parseAmount(v string) (string, bool) {
+	v = removeSeparators(v, " '\u00a0\u202f")
+	decimal := byte(0)
+	lastDot, lastComma := strings.LastIndexByte(v, '.'), strings.LastIndexByte(v, ',')
+	switch {
+	case lastDot >= 0 && lastComma >= 0:
+		decimal = '.'
+		if lastComma > lastDot {
+			decimal = ','
+		}
+	case lastDot >= 0 && strings.Count(v, ".") == 1 && len(v)-lastDot-1 != 3:
+		decimal = '.'
+	case lastComma >= 0 && strings.Count(v, ",") == 1 && len(v)-lastComma-1 != 3:
+		decimal = ','
+	}
+
+	integer, fraction := v, ""
+	if decimal != 0 {
+		i := strings.LastIndexByte(v, decimal)
+		integer, fraction = v[:i], v[i+1:]
+		if strings.ContainsAny(fraction, ".,") {
+			return "", false
+		}
+	}
+	groups := strings.FieldsFunc(integer, func(r rune) bool { return r == '.' || r == ',' })
+	if len(groups) == 0 || strings.Count(integer, ".")+strings.Count(integer, ",") != len(groups)-1 ||
+		(strings.Contains(integer, ".") && strings.Contains(integer, ",")) {
+		return "", false
+	}
+	if len(groups) > 1 {
+		if len(groups[0]) > 3 || len(groups[len(groups)-1]) != 3 {
+			return "", false
+		}
+		for _, g := range groups[1 : len(groups)-1] {
+			if len(g) != 2 && len(g) != 3 {
+				return "", false
+			}
+		}
+	}
+	amount := strings.Join(groups, "")
+	if fraction != "" {
+		amount += "." + fraction
+	}
+	return amount, true
+}
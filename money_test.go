@@ -0,0 +1,74 @@
+package inspectdata
+
+import (
+	"testing"
+)
+
+func TestInspectMoney(t *testing.T) {
+	amounts := map[string]MoneyInfo{
+		"€1.234,56":            {"1234.56", "EUR"},
+		"1 234,56 €":           {"1234.56", "EUR"},
+		"1\u00a0234,56\u00a0€": {"1234.56", "EUR"},
+		"£1,234":               {"1234", "GBP"},
+		"R$ 10,00":             {"10.00", "BRL"},
+		"₹1,23,456.78":         {"123456.78", "INR"},
+		"1,23,456.78 INR":      {"123456.78", "INR"},
+		"CHF 1'234.50":         {"1234.50", "CHF"},
+		"-¥500":                {"-500", "JPY"},
+		"€-12,5":               {"-12.5", "EUR"},
+		"100 USD":              {"100", "USD"},
+		"EUR 1.000.000":        {"1000000", "EUR"},
+		"12,50 zł":             {"12.50", "PLN"},
+		"C$20":                 {"20", "CAD"},
+	}
+	for v, expected := range amounts {
+		datum, err := Inspect(v)
+		if err != nil {
+			t.Error(err)
+		}
+		if datum.Canonical != Money || datum.Money == nil || *datum.Money != expected {
+			t.Errorf("Inspect should have detected Money %+v for %q, but got: %v %+v", expected, v, datum.Canonical, datum.Money)
+		}
+	}
+
+	// no amount, unknown currency, malformed grouping, currency on both sides or neither
+	invalid := []string{
+		"EUR",
+		"12 ABC",
+		"1.234.5 €",
+		"€1,2345,678",
+		"€ 12 $",
+		"1.234,56",
+		"-€-5",
+	}
+	for _, v := range invalid {
+		if c, _ := inspectString(v); c == Money {
+			t.Errorf("inspectString should not have detected canonical type Money for %q", v)
+		}
+	}
+}
+
+func TestInspectUSDMoney(t *testing.T) {
+	amounts := map[string]string{"$1,234.56": "1234.56", "$ -0.01": "-0.01", "+0.01": "0.01", "1.01": "1.01"}
+	for v, expected := range amounts {
+		datum, _ := Inspect(v)
+		if datum.Canonical != USD || datum.Money == nil || *datum.Money != (MoneyInfo{expected, "USD"}) {
+			t.Errorf("Inspect should have detected USD %s for %q, but got: %v %+v", expected, v, datum.Canonical, datum.Money)
+		}
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	amounts := map[string]string{"1.234": "1234", "1,234": "1234", "12,5": "12.5", "1.5": "1.5", "1,234,567.89": "1234567.89",
+		"1.234.567,89": "1234567.89", "1 234 567": "1234567", "12'345.6": "12345.6", "0,99": "0.99"}
+	for v, expected := range amounts {
+		if amount, ok := parseAmount(v); !ok || amount != expected {
+			t.Errorf("parseAmount should have parsed %q as %s, but got: %s", v, expected, amount)
+		}
+	}
+	for _, v := range []string{"1.234,5.6", "1,2,3", "1234,567.89", "1.234,567,89"} {
+		if amount, ok := parseAmount(v); ok {
+			t.Errorf("parseAmount should not have parsed %q, but got: %s", v, amount)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package inspectdata
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Regular Expressions for machine-readable zone (MRZ) lines and passport numbers
+const reMRZLine = "^[A-Z0-9<]+$"
+const rePassportNumber = "^[A-Z0-9]{6,9}$"
+
+var validMRZLine = regexp.MustCompile(reMRZLine)
+var validPassportNumber = regexp.MustCompile(rePassportNumber)
+
+// ICAO 9303 check digit weights repeating over the characters of a field
+var mrzWeights = [3]int{7, 3, 1}
+
+// Field name hints indicating a passport number
+var passportHints = []string{"passport", "passportno", "passportnumber"}
+
+// Passport number formats of common issuing countries, other countries fall back to any 6 to 9 character
+// alphanumeric ICAO document number containing a digit
+var passportFormats = map[string]*regexp.Regexp{
+	"AU": regexp.MustCompile("^[A-Z]{1,2}[0-9]{7}$"),
+	"BR": regexp.MustCompile("^[A-Z]{2}[0-9]{6}$"),
+	"CA": regexp.MustCompile("^[A-Z]{2}[0-9]{6}$"),
+	"CN": regexp.MustCompile("^[EG][0-9]{8}$"),
+	"DE": regexp.MustCompile("^[CFGHJKLMNPRTVWXYZ][CFGHJKLMNPRTVWXYZ0-9]{8}$"),
+	"ES": regexp.MustCompile("^[A-Z]{3}[0-9]{6}$"),
+	"FR": regexp.MustCompile("^[0-9]{2}[A-Z]{2}[0-9]{5}$"),
+	"GB": regexp.MustCompile("^[0-9]{9}$"),
+	"IN": regexp.MustCompile("^[A-Z][0-9]{7}$"),
+	"IT": regexp.MustCompile("^[A-Z0-9]{2}[0-9]{7}$"),
+	"JP": regexp.MustCompile("^[A-Z]{2}[0-9]{7}$"),
+	"MX": regexp.MustCompile("^[A-Z][0-9]{8}$"),
+	"NL": regexp.MustCompile("^[A-NP-Z]{2}[A-NP-Z0-9]{6}[0-9]$"),
+	"US": regexp.MustCompile("^([0-9]{9}|[A-Z][0-9]{8})$"),
+}
+
+// Machine-readable zone of a travel document parsed per ICAO 9303
+type mrz struct {
+	documentCode string // Document code ex: P for passport, I for identity card
+	number       string // Document number
+	nationality  string // Nationality ISO ALPHA-3 Code ex: UTO
+	birthDate    string // Birth date YYMMDD
+	expiryDate   string // Expiry date YYMMDD
+	name         string // Holder's given names followed by surname
+}
+
+// Calculates the ICAO 9303 check digit of a MRZ field: characters valued 0-9 for digits,
+// 10-35 for A-Z and 0 for the < filler are weighted 7, 3, 1 repeating, mod 10.
+func mrzCheckDigit(field string) byte {
+	sum := 0
+	for i := 0; i < len(field); i++ {
+		value := 0
+		switch c := field[i]; {
+		case c >= '0' && c <= '9':
+			value = int(c - '0')
+		case c >= 'A' && c <= 'Z':
+			value = int(c-'A') + 10
+		}
+		sum += value * mrzWeights[i%3]
+	}
+	return byte('0' + sum%10)
+}
+
+// Validates the check digit of a MRZ field, where optional fields left entirely as < filler may have a < check.
+func validMRZCheck(field string, check byte) bool {
+	if check == '<' {
+		return strings.Trim(field, "<") == ""
+	}
+	return mrzCheckDigit(field) == check
+}
+
+// Splits the MRZ into its lines of equal length, either separated by line breaks or concatenated
+// as a single string of 90 (TD1), 72 (TD2) or 88 (TD3) characters.
+func mrzLines(v string) []string {
+	lines := strings.Fields(v)
+	if len(lines) == 1 {
+		switch len(v) {
+		case 90:
+			lines = []string{v[:30], v[30:60], v[60:]}
+		case 72:
+			lines = []string{v[:36], v[36:]}
+		case 88:
+			lines = []string{v[:44], v[44:]}
+		}
+	}
+	for _, line := range lines {
+		if len(line) != len(lines[0]) || !validMRZLine.MatchString(line) {
+			return nil
+		}
+	}
+	return lines
+}
+
+// Parses a TD1 (3 lines of 30), TD2 (2 lines of 36) or TD3 passport (2 lines of 44) machine-readable zone,
+// validating the document number, birth date, expiry date, optional data and composite check digits.
+func parseMRZ(v string) (mrz, bool) {
+	lines := mrzLines(v)
+	var m mrz
+	var composite, numberCheck, birthCheck, expiryCheck string
+	var name string
+	switch {
+	case len(lines) == 3 && len(lines[0]) == 30:
+		m.number, numberCheck = lines[0][5:14], lines[0][14:15]
+		m.birthDate, birthCheck = lines[1][0:6], lines[1][6:7]
+		m.expiryDate, expiryCheck = lines[1][8:14], lines[1][14:15]
+		m.nationality = lines[1][15:18]
+		composite = lines[0][5:30] + lines[1][0:7] + lines[1][8:15] + lines[1][18:29]
+		name = lines[2]
+	case len(lines) == 2 && (len(lines[0]) == 36 || len(lines[0]) == 44):
+		line := lines[1]
+		m.number, numberCheck = line[0:9], line[9:10]
+		m.nationality = line[10:13]
+		m.birthDate, birthCheck = line[13:19], line[19:20]
+		m.expiryDate, expiryCheck = line[21:27], line[27:28]
+		composite = line[0:10] + line[13:20] + line[21 : len(line)-1]
+		if len(line) == 44 && !validMRZCheck(line[28:42], line[42]) {
+			return mrz{}, false
+		}
+		name = lines[0][5:]
+	default:
+		return mrz{}, false
+	}
+	compositeCheck := lines[1][len(lines[1])-1]
+	if !isDigits(m.birthDate) || !isDigits(m.expiryDate) ||
+		!validMRZCheck(m.number, numberCheck[0]) || !validMRZCheck(m.birthDate, birthCheck[0]) ||
+		!validMRZCheck(m.expiryDate, expiryCheck[0]) || !validMRZCheck(composite, compositeCheck) {
+		return mrz{}, false
+	}
+
+	m.documentCode = strings.TrimRight(lines[0][0:2], "<")
+	m.number = strings.TrimRight(m.number, "<")
+	m.nationality = strings.TrimRight(m.nationality, "<")
+	m.name = mrzName(name)
+	return m, true
+}
+
+// Converts a MRZ name field SURNAME<<GIVEN<NAMES into given names followed by surname ex: GIVEN NAMES SURNAME
+func mrzName(field string) string {
+	parts := strings.SplitN(strings.TrimRight(field, "<"), "<<", 2)
+	surname := strings.ReplaceAll(parts[0], "<", " ")
+	if len(parts) == 1 {
+		return surname
+	}
+	return strings.ReplaceAll(parts[1], "<", " ") + " " + surname
+}
+
+// Inspects the string to determine if it is a machine-readable zone of a travel document with valid check digits.
+// Returns Unknown if the string is not a MRZ.
+func inspectMRZ(v string) CanonicalType {
+	if _, ok := parseMRZ(v); ok {
+		return MRZ
+	}
+	return Unknown
+}
+
+// Extracts the document number, nationality, birth date, expiry date and name of the MRZ as findings.
+func mrzFindings(v string) []Datum {
+	m, ok := parseMRZ(v)
+	if !ok {
+		return nil
+	}
+	number := DocumentNumber
+	if strings.HasPrefix(m.documentCode, "P") {
+		number = PassportNumber
+	}
+	return []Datum{
+		{Data: m.number, DataType: "string", Canonical: number, IsPII: true, Field: "document_number"},
+		{Data: m.nationality, DataType: "string", Canonical: CountryCode3, Field: "nationality"},
+		{Data: m.birthDate, DataType: "string", Canonical: DateYYMMDD, IsPII: true, Field: "birth_date"},
+		{Data: m.expiryDate, DataType: "string", Canonical: DateYYMMDD, Field: "expiry_date"},
+		{Data: m.name, DataType: "string", Canonical: PersonName, IsPII: true, Field: "name"},
+	}
+}
+
+// Inspects the string using the field name and country of the options to determine if it is a passport number
+// of the country's format, or any ICAO document number when the country's format is unknown.
+// Returns Unknown if the options do not indicate a passport number for the data.
+func inspectPassportContext(v string, opts Options) CanonicalType {
+	if !hasFieldHint(opts.Field, passportHints...) {
+		return Unknown
+	}
+	v = strings.ToUpper(removeSeparators(v, " "))
+	format, ok := passportFormats[strings.ToUpper(opts.Country)]
+	if !ok {
+		format = validPassportNumber
+	}
+	if !format.MatchString(v) || !strings.ContainsAny(v, "0123456789") {
+		return Unknown
+	}
+	return PassportNumber
+}
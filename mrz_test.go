@@ -0,0 +1,96 @@
+package inspectdata
+
+import (
+	"testing"
+)
+
+// ICAO 9303 specimen machine-readable zones
+const mrzTD1 = "I<UTOD231458907<<<<<<<<<<<<<<<\n7408122F1204159UTO<<<<<<<<<<<6\nERIKSSON<<ANNA<MARIA<<<<<<<<<<"
+const mrzTD2 = "I<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<\nD231458907UTO7408122F1204159<<<<<<<6"
+const mrzTD3 = "P<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<<<<<<<<<\nL898902C36UTO7408122F1204159ZE184226B<<<<<10"
+
+func TestMRZCheckDigit(t *testing.T) {
+	checks := map[string]byte{"L898902C3": '6', "740812": '2', "120415": '9', "ZE184226B<<<<<": '1', "<<<<<<<<<<<<<<": '0'}
+	for v, expected := range checks {
+		if c := mrzCheckDigit(v); c != expected {
+			t.Errorf("mrzCheckDigit should have calculated %c for %s, but got: %c", expected, v, c)
+		}
+	}
+}
+
+func TestInspectMRZ(t *testing.T) {
+	valid := []string{mrzTD1, mrzTD2, mrzTD3, "P<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<<<<<<<<<L898902C36UTO7408122F1204159ZE184226B<<<<<10",
+		"I<UTOD231458907<<<<<<<<<<<<<<<\r\n7408122F1204159UTO<<<<<<<<<<<6\r\nERIKSSON<<ANNA<MARIA<<<<<<<<<<"}
+	for _, v := range valid {
+		if c, _ := inspectString(v); c != MRZ {
+			t.Errorf("inspectString should have detected canonical type MRZ for %q, but got: %v", v, c)
+		}
+	}
+
+	// invalid document number, birth date and composite check digits, lines of unequal length
+	invalid := []string{
+		"P<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<<<<<<<<<\nL898902C37UTO7408122F1204159ZE184226B<<<<<10",
+		"P<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<<<<<<<<<\nL898902C36UTO7408123F1204159ZE184226B<<<<<10",
+		"P<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<<<<<<<<<\nL898902C36UTO7408122F1204159ZE184226B<<<<<11",
+		"P<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<\nL898902C36UTO7408122F1204159ZE184226B<<<<<10",
+	}
+	for _, v := range invalid {
+		if c, _ := inspectString(v); c == MRZ {
+			t.Errorf("inspectString should not have detected canonical type MRZ for %q", v)
+		}
+	}
+}
+
+func TestInspectMRZFindings(t *testing.T) {
+	datum, err := Inspect(mrzTD3)
+	if err != nil {
+		t.Error(err)
+	}
+	if datum.Canonical != MRZ || !datum.IsPII || len(datum.Findings) != 5 {
+		t.Fatalf("Inspect should have detected PII MRZ with 5 findings, but got: %+v", datum)
+	}
+
+	expected := []Datum{
+		{Data: "L898902C3", Canonical: PassportNumber, IsPII: true, Field: "document_number"},
+		{Data: "UTO", Canonical: CountryCode3, Field: "nationality"},
+		{Data: "740812", Canonical: DateYYMMDD, IsPII: true, Field: "birth_date"},
+		{Data: "120415", Canonical: DateYYMMDD, Field: "expiry_date"},
+		{Data: "ANNA MARIA ERIKSSON", Canonical: PersonName, IsPII: true, Field: "name"},
+	}
+	for i, e := range expected {
+		f := datum.Findings[i]
+		if f.Data != e.Data || f.Canonical != e.Canonical || f.IsPII != e.IsPII || f.Field != e.Field {
+			t.Errorf("Inspect should have found %+v, but got: %+v", e, f)
+		}
+	}
+
+	datum, _ = Inspect(mrzTD1)
+	if len(datum.Findings) == 0 || datum.Findings[0].Data != "D23145890" || datum.Findings[0].Canonical != DocumentNumber {
+		t.Errorf("Inspect should have found DocumentNumber D23145890 of ID card, but got: %+v", datum.Findings)
+	}
+}
+
+func TestInspectPassportNumber(t *testing.T) {
+	contexts := []struct {
+		data     string
+		opts     Options
+		expected CanonicalType
+	}{
+		{"L898902C3", Options{Field: "passport_no"}, PassportNumber},
+		{"123456789", Options{Field: "passportNumber", Country: "GB"}, PassportNumber},
+		{"C01X00T47", Options{Field: "passport", Country: "de"}, PassportNumber},
+		{"AB123456", Options{Field: "passport", Country: "CA"}, PassportNumber},
+		{"ABC123", Options{Field: "passport", Country: "CA"}, Unknown},
+		{"ABCDEFGH", Options{Field: "passport"}, Unknown},
+	}
+	for _, ctx := range contexts {
+		if c := inspectContext(ctx.data, ctx.opts); c != ctx.expected {
+			t.Errorf("inspectContext should have detected %v for %s with %+v, but got: %v", ctx.expected, ctx.data, ctx.opts, c)
+		}
+	}
+
+	datum, _ := InspectWithOptions("C01X00T47", Options{Field: "passport", Country: "de"})
+	if !datum.IsPII || datum.Country != "DE" {
+		t.Errorf("InspectWithOptions should have detected PII passport number of DE, but got: %+v", datum)
+	}
+}
@@ -0,0 +1,269 @@
+package inspectdata
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+// Minimum score 0 to 1 of data to be identified as a PersonName. Lower to favor recall or raise to favor precision
+// against ordinary words, ex: John Smith scores 0.8 alone, and john smith scores 1 in a field named customer_name.
+var PersonNameThreshold = 0.7
+
+// Score weights of person names
+const (
+	nameFirstWeight       = 0.3  // Weight of a known first name leading the name
+	nameLastWeight        = 0.3  // Weight of a known last name ending the name
+	nameSingleWeight      = 0.45 // Weight of a known first or last name alone
+	nameUnknownLastWeight = 0.1  // Weight of a capitalized unknown last name following a known first name
+	nameUnknownPenalty    = 0.2  // Penalty of each unknown middle name other than an initial
+	nameCaseWeight        = 0.2  // Weight of names written in title case or uppercase
+	nameHonorificWeight   = 0.3  // Weight of a leading honorific ex: Dr.
+	nameContextWeight     = 0.5  // Weight of a field name indicating a person's name
+	maxNameTokens         = 4    // Maximum number of names excluding honorifics and suffixes
+)
+
+// First and last names by locale in order of frequency, most frequent first
+type nameList struct {
+	locale string // Locale of the names ex: en
+	first  string // First names lowercase separated by spaces
+	last   string // Last names lowercase separated by spaces
+}
+
+var nameLists = []nameList{
+	{"en",
+		"james mary john patricia robert jennifer michael linda william elizabeth david barbara richard susan joseph jessica " +
+			"thomas sarah charles karen christopher nancy daniel lisa matthew betty anthony margaret mark sandra donald ashley " +
+			"steven emily paul donna andrew michelle joshua carol kenneth amanda kevin melissa brian deborah george stephanie " +
+			"edward rebecca ronald laura timothy sharon jason cynthia jeffrey kathleen ryan amy jacob helen gary anna nicholas " +
+			"emma eric olivia jack sophie oliver grace harry alice peter rose will bill bob tom jim joe ann jane kate",
+		"smith johnson williams brown jones garcia miller davis rodriguez martinez hernandez lopez gonzalez wilson anderson " +
+			"thomas taylor moore jackson martin lee perez thompson white harris sanchez clark ramirez lewis robinson walker " +
+			"young allen king wright scott torres nguyen hill flores green adams nelson baker hall rivera campbell mitchell " +
+			"carter roberts evans turner phillips parker collins edwards stewart morris murphy cook rogers morgan cooper " +
+			"patel hughes wood price bennett gray james reed kelly howard ward cox watson brooks doe"},
+	{"es",
+		"maria jose antonio juan manuel francisco carmen ana luis javier carlos miguel pedro jesus alejandro rafael " +
+			"pablo sergio fernando jorge alberto lucia laura marta elena isabel cristina paula sara rosa pilar dolores " +
+			"guadalupe sofia valentina camila diego andres mateo santiago",
+		"garcia rodriguez gonzalez fernandez lopez martinez sanchez perez gomez martin jimenez ruiz hernandez diaz moreno " +
+			"alvarez munoz romero alonso gutierrez navarro torres dominguez vazquez ramos gil ramirez serrano blanco suarez " +
+			"molina morales ortega delgado castro ortiz rubio marin sanz nunez iglesias medina garrido cortes castillo"},
+	{"pt",
+		"joao jose antonio francisco carlos paulo pedro lucas luiz marcos gabriel rafael daniel marcelo bruno eduardo " +
+			"maria ana francisca antonia adriana juliana marcia fernanda patricia aline beatriz leonor mariana joana",
+		"silva santos oliveira souza sousa rodrigues ferreira alves pereira lima gomes costa ribeiro martins carvalho " +
+			"almeida lopes soares fernandes vieira barbosa rocha dias nascimento andrade moreira nunes marques machado mendes"},
+	{"de",
+		"peter michael thomas andreas wolfgang klaus jurgen stefan christian uwe werner hans bernd frank dieter " +
+			"maria ursula monika petra elisabeth sabine renate helga karin brigitte ingrid erika andrea gisela claudia " +
+			"lukas leon finn jonas felix lena hannah lea mia",
+		"muller mueller schmidt schneider fischer weber meyer wagner becker schulz hoffmann schafer koch bauer richter " +
+			"klein wolf schroder neumann schwarz zimmermann braun kruger hofmann hartmann lange schmitt werner krause meier"},
+	{"fr",
+		"jean pierre michel andre philippe rene louis alain jacques bernard marcel daniel roger claude francois nicolas " +
+			"marie jeanne francoise monique catherine nathalie isabelle sylvie anne jacqueline sophie camille chloe manon " +
+			"antoine julien hugo theo",
+		"martin bernard dubois thomas robert richard petit durand leroy moreau simon laurent lefebvre michel garcia " +
+			"david bertrand roux vincent fournier morel girard andre lefevre mercier dupont lambert bonnet francois martinez"},
+	{"it",
+		"giuseppe giovanni antonio mario luigi francesco angelo vincenzo pietro salvatore carlo franco domenico bruno " +
+			"paolo marco alessandro andrea matteo lorenzo maria anna giuseppina rosa angela giovanna teresa lucia carmela " +
+			"francesca giulia chiara martina",
+		"rossi russo ferrari esposito bianchi romano colombo ricci marino greco bruno gallo conti luca mancini " +
+			"costa giordano rizzo lombardi moretti barbieri fontana santoro mariani rinaldi caruso ferrara galli martini"},
+	{"nl",
+		"jan johannes cornelis hendrik willem pieter gerrit jacobus daan sem bram maria johanna anna cornelia wilhelmina " +
+			"elisabeth emma julia sanne lotte",
+		"jansen visser smit meijer bakker mulder bos vos peters hendriks dekker brouwer dijkstra vermeulen kok " +
+			"vries dijk berg janssen"},
+	{"sv",
+		"lars mikael anders johan erik per karl peter jan thomas nils olof maria elisabeth anna kristina margareta eva " +
+			"birgitta karin marie astrid sigrid",
+		"andersson johansson karlsson nilsson eriksson larsson olsson persson svensson gustafsson pettersson jonsson " +
+			"jansson hansson bengtsson hansen johansen olsen nielsen jensen pedersen"},
+	{"pl",
+		"piotr krzysztof andrzej tomasz pawel jan michal marcin jakub adam anna maria katarzyna malgorzata agnieszka " +
+			"barbara ewa krystyna elzbieta zofia",
+		"nowak kowalski wisniewski wojcik kowalczyk kaminski lewandowski zielinski szymanski wozniak dabrowski " +
+			"kozlowski jankowski mazur kwiatkowski krawczyk"},
+	{"hi",
+		"aarav vivaan aditya vihaan arjun rahul amit rajesh sanjay suresh ramesh vijay anil sunil priya pooja anita " +
+			"sunita neha kavita deepika lakshmi ananya diya",
+		"sharma verma gupta singh kumar patel shah mehta joshi reddy rao iyer nair menon das chatterjee banerjee " +
+			"mukherjee agarwal jain khan mishra pandey yadav"},
+	{"zh",
+		"wei fang min jing li jun yan ying hua ping ming lei jie tao chao hong xiu yong qiang xin",
+		"wang li zhang liu chen yang huang zhao wu zhou xu sun ma zhu hu guo he lin luo gao zheng liang xie tang"},
+	{"ja",
+		"hiroshi takashi akira yuki yuto haruto sota ren kenji satoshi daisuke kazuki yui hina aoi sakura yoko keiko " +
+			"akiko naoko tomoko",
+		"sato suzuki takahashi tanaka watanabe ito yamamoto nakamura kobayashi kato yoshida yamada sasaki yamaguchi " +
+			"matsumoto inoue kimura hayashi shimizu"},
+	{"ko",
+		"min-jun seo-yeon ji-hoon ji-woo hyun-woo soo-jin young-ho sung-min ji-young eun-ji",
+		"kim lee park choi jung kang cho yoon jang lim han oh seo shin kwon hwang ahn song yoo hong"},
+}
+
+// Honorifics preceding and suffixes following names, lowercase without periods
+var nameHonorifics = stringSet("mr mrs ms miss mx dr prof sir dame lord lady rev fr herr frau sr sra srta mme mlle sig")
+var nameSuffixes = stringSet("jr sr ii iii iv phd md esq")
+
+// Particles of last names ex: van of Vincent van Gogh
+var nameParticles = stringSet("de da di del della der den van von la le du dos das")
+
+// Ordinary words that are also names, only given half the weight of the name when written lowercase or alone
+var nameCommonWords = stringSet("will bill mark rose grace joy hope may june april august young king white brown " +
+	"green gray wood price reed hill hall cook ward wolf long bell rich ren ana min jan he ma oh per sun song")
+
+// Field name hints indicating a person's name, and hints of other names such as a username or company name
+var personNameHints = []string{"name", "firstname", "lastname", "surname", "fullname", "givenname", "familyname",
+	"nombre", "apellido", "vorname", "nachname", "prenom"}
+var otherNameHints = []string{"user", "username", "login", "host", "hostname", "file", "filename", "server", "domain",
+	"company", "business", "product", "brand", "app", "database", "table", "column", "key", "display", "nick"}
+
+// Field names of a person's role, only indicating a name as the whole field name ex: cardholder but not cardholder_tier
+var personRoleFields = stringSet("cardholder holder accountholder customer patient contact")
+
+// Frequency weights 0.75 to 1 of names by rank within their list, where the most frequent rank of any locale is kept
+var firstNames, lastNames = nameFrequencies()
+
+// Builds the frequency weights of the first and last names of every locale.
+func nameFrequencies() (map[string]float64, map[string]float64) {
+	first, last := make(map[string]float64), make(map[string]float64)
+	add := func(weights map[string]float64, list string) {
+		names := strings.Fields(list)
+		for rank, name := range names {
+			if w := 1 - 0.25*float64(rank)/float64(len(names)); w > weights[name] {
+				weights[name] = w
+			}
+		}
+	}
+	for _, l := range nameLists {
+		add(first, l.first)
+		add(last, l.last)
+	}
+	return first, last
+}
+
+// Inspects the string to determine if it is a person's name scoring at least PersonNameThreshold, using the field name
+// of the options if any. Returns Unknown if the string is not likely a person's name.
+func inspectPersonName(v string, opts Options) CanonicalType {
+	if personNameScore(v, opts) >= PersonNameThreshold {
+		return PersonName
+	}
+	return Unknown
+}
+
+// Scores 0 to 1 the likelihood of the string being a person's name ex: John Smith, Smith, John or Dr. Jane Doe
+// from the frequency of its first and last names, capitalization, honorifics and a field name indicating a name,
+// where at least one of the names must be a known first or last name.
+func personNameScore(v string, opts Options) float64 {
+	tokens := strings.Fields(v)
+	// last name first ex: Smith, John
+	if comma := strings.IndexByte(v, ','); comma > 0 && strings.Count(v, ",") == 1 {
+		tokens = append(strings.Fields(v[comma+1:]), strings.Fields(v[:comma])...)
+	}
+
+	score := 0.0
+	for len(tokens) > 0 && nameHonorifics[nameKey(tokens[0])] {
+		score = nameHonorificWeight
+		tokens = tokens[1:]
+	}
+	for len(tokens) > 1 && nameSuffixes[nameKey(tokens[len(tokens)-1])] {
+		tokens = tokens[:len(tokens)-1]
+	}
+	if len(tokens) == 0 || len(tokens) > maxNameTokens {
+		return 0
+	}
+	// case, honorifics and context alone do not make a name without a known first or last name
+	known := false
+	for _, token := range tokens {
+		if !isNameToken(token) {
+			return 0
+		}
+		known = known || firstNames[nameKey(token)] > 0 || lastNames[nameKey(token)] > 0
+	}
+	if !known {
+		return 0
+	}
+
+	titled, lower := true, true
+	for _, token := range tokens {
+		runes := []rune(token)
+		titled = titled && (unicode.IsUpper(runes[0]) || nameParticles[token])
+		lower = lower && token == strings.ToLower(token)
+	}
+	if titled {
+		score += nameCaseWeight
+	}
+	weight := func(weights map[string]float64, token string) float64 {
+		w := weights[nameKey(token)]
+		if nameCommonWords[nameKey(token)] && (lower || len(tokens) == 1) {
+			w /= 2
+		}
+		return w
+	}
+
+	first, last := tokens[0], tokens[len(tokens)-1]
+	if len(tokens) == 1 {
+		score += nameSingleWeight * math.Max(weight(firstNames, first), weight(lastNames, first))
+	} else {
+		// a first name used as a last name or the reverse is given half the weight ex: Taylor
+		score += nameFirstWeight * math.Max(weight(firstNames, first), weight(lastNames, first)/2)
+		lastWeight := math.Max(weight(lastNames, last), weight(firstNames, last)/2)
+		if lastWeight == 0 && titled && firstNames[nameKey(first)] > 0 {
+			score += nameUnknownLastWeight
+		}
+		score += nameLastWeight * lastWeight
+		for _, middle := range tokens[1 : len(tokens)-1] {
+			key := nameKey(middle)
+			if len([]rune(key)) > 1 && !nameParticles[key] && firstNames[key] == 0 && lastNames[key] == 0 {
+				score -= nameUnknownPenalty
+			}
+		}
+	}
+	if isPersonNameField(opts.Field) {
+		score += nameContextWeight
+	}
+	return math.Max(0, math.Min(1, score))
+}
+
+// Determines if the field name indicates a person's name ex: customer_name or cardholder, but not customer_city.
+func isPersonNameField(field string) bool {
+	if field == "" || hasFieldHint(field, otherNameHints...) {
+		return false
+	}
+	return hasFieldHint(field, personNameHints...) || personRoleFields[strings.Join(fieldTokens(field), "")]
+}
+
+// Determines if the token is written as a name: letters with any apostrophes, hyphens and a trailing period of an initial.
+func isNameToken(token string) bool {
+	runes := []rune(strings.TrimSuffix(token, "."))
+	if len(runes) == 0 || !unicode.IsLetter(runes[0]) || (len(runes) > 1 && token != strings.TrimSuffix(token, ".")) {
+		return false
+	}
+	for _, r := range runes {
+		if !unicode.IsLetter(r) && r != '\'' && r != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// Normalizes the name token to the lowercase form of the name lists, removing periods and Latin diacritics.
+func nameKey(token string) string {
+	return removeDiacritics(strings.ToLower(strings.Replace(token, ".", "", -1)))
+}
+
+// Removes the diacritics of common Latin letters ex: müller to muller
+func removeDiacritics(v string) string {
+	return diacriticReplacer.Replace(v)
+}
+
+var diacriticReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ä", "a", "ã", "a", "å", "a", "ą", "a", "ç", "c", "ć", "c", "č", "c",
+	"é", "e", "è", "e", "ê", "e", "ë", "e", "ę", "e", "í", "i", "ì", "i", "î", "i", "ï", "i", "ł", "l",
+	"ñ", "n", "ń", "n", "ó", "o", "ò", "o", "ô", "o", "ö", "o", "õ", "o", "ø", "o", "ś", "s", "š", "s", "ß", "ss",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u", "ý", "y", "ź", "z", "ż", "z", "ž", "z",
+)
@@ -0,0 +1,94 @@
+package inspectdata
+
+import (
+	"testing"
+)
+
+func TestInspectPersonName(t *testing.T) {
+	names := []string{
+		"John Smith",
+		"Maria Garcia Lopez",
+		"Dr. Jane Doe",
+		"Mr Smith",
+		"Smith, John",
+		"ANNA MARIA ERIKSSON",
+		"Jürgen Müller",
+		"Priya Sharma",
+		"Jan van Dijk",
+		"Robert J. Wilson Jr.",
+		"Hiroshi Tanaka",
+	}
+	for _, v := range names {
+		datum, err := Inspect(v)
+		if err != nil {
+			t.Error(err)
+		}
+		if datum.Canonical != PersonName || !datum.IsPII || datum.Score < PersonNameThreshold {
+			t.Errorf("Inspect should have detected PII PersonName for %s, but got: %v score %v", v, datum.Canonical, datum.Score)
+		}
+	}
+
+	// ordinary words, lowercase names and single names without context
+	ordinary := []string{
+		"New York",
+		"Rose Garden",
+		"will smith",
+		"John",
+		"the quick brown fox",
+		"Smith 42",
+		"Grace Hopper",
+	}
+	for _, v := range ordinary {
+		if c, _ := inspectString(v); c == PersonName {
+			t.Errorf("inspectString should not have detected canonical type PersonName for %s, score %v", v, personNameScore(v, Options{}))
+		}
+	}
+}
+
+func TestInspectPersonNameContext(t *testing.T) {
+	names := map[string]string{
+		"john smith":   "customer_name",
+		"John":         "firstName",
+		"Nakamura":     "last_name",
+		"Grace Hopper": "cardholder",
+	}
+	for v, field := range names {
+		datum, _ := InspectWithOptions(v, Options{Field: field})
+		if datum.Canonical != PersonName {
+			t.Errorf("InspectWithOptions should have detected canonical type PersonName for %s in field %s, but got: %v", v, field, datum.Canonical)
+		}
+	}
+
+	// names of other things than a person, and ordinary values of other fields of a person
+	others := map[string]string{
+		"john":          "username",
+		"Smith Trading": "company_name",
+		"Hopper":        "last_name",
+		"Springfield":   "customer_city",
+		"Germany":       "customer_country",
+		"Active":        "contact_status",
+		"Pending":       "customer_status",
+		"Gold":          "cardholder_tier",
+		"CA":            "patient_state",
+		"Jones":         "customer_city",
+	}
+	for v, field := range others {
+		datum, _ := InspectWithOptions(v, Options{Field: field})
+		if datum.Canonical == PersonName {
+			t.Errorf("InspectWithOptions should not have detected canonical type PersonName for %s in field %s", v, field)
+		}
+	}
+}
+
+func TestPersonNameThreshold(t *testing.T) {
+	defer func(threshold float64) { PersonNameThreshold = threshold }(PersonNameThreshold)
+
+	PersonNameThreshold = 0.4
+	if c, _ := inspectString("Grace Hopper"); c != PersonName {
+		t.Errorf("inspectString should have detected canonical type PersonName with a lower threshold, but got: %v", c)
+	}
+	PersonNameThreshold = 0.9
+	if c, _ := inspectString("John Smith"); c == PersonName {
+		t.Errorf("inspectString should not have detected canonical type PersonName with a higher threshold")
+	}
+}
@@ -0,0 +1,206 @@
+package inspectdata
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Regular Expressions for obfuscated data written out in words
+// ex: 4111 one one one ... and john dot smith at example dot com
+const reSpelledDigits = `(?i)\b(?:[0-9]+|zero|one|two|three|four|five|six|seven|eight|nine)(?:[\s-]+(?:[0-9]+|zero|one|two|three|four|five|six|seven|eight|nine))*\b`
+const reObfuscatedAt = `(?i)\s*[\(\[\{<]\s*at\s*[\)\]\}>]\s*|\s+at\s+`
+const reObfuscatedDot = `(?i)\s*[\(\[\{<]\s*dot\s*[\)\]\}>]\s*|\s+dot\s+`
+const reObfuscatedEmail = `(?i)[\p{L}\p{N}._%+-]+(?:(?:\s*[\(\[\{<]\s*dot\s*[\)\]\}>]\s*|\s+dot\s+)[\p{L}\p{N}._%+-]+)*` +
+	`(?:\s*[\(\[\{<]\s*at\s*[\)\]\}>]\s*|\s+at\s+)` +
+	`[\p{L}\p{N}-]+(?:(?:\s*[\(\[\{<]\s*dot\s*[\)\]\}>]\s*|\s+dot\s+|\.)[\p{L}\p{N}-]+)+`
+
+var validSpelledDigits = regexp.MustCompile(reSpelledDigits)
+var validObfuscatedAt = regexp.MustCompile(reObfuscatedAt)
+var validObfuscatedDot = regexp.MustCompile(reObfuscatedDot)
+var validObfuscatedEmail = regexp.MustCompile(reObfuscatedEmail)
+
+// Digits spelled out in English
+var spelledDigits = map[string]byte{
+	"zero": '0', "one": '1', "two": '2', "three": '3', "four": '4',
+	"five": '5', "six": '6', "seven": '7', "eight": '8', "nine": '9',
+}
+
+// Zero code points of decimal digit blocks of other scripts left as is by NFKC, each followed by the digits 1 to 9
+// ex: Arabic-Indic, Devanagari and Thai digits
+var digitZeros = []rune{
+	0x0660, 0x06F0, 0x07C0, 0x0966, 0x09E6, 0x0A66, 0x0AE6, 0x0B66, 0x0BE6, 0x0C66, 0x0CE6, 0x0D66, 0x0E50,
+	0x0ED0, 0x0F20, 0x1040, 0x17E0, 0x1810,
+}
+
+// Punctuation and Cyrillic and Greek letters confusable with ASCII, which NFKC leaves as is
+var confusables = map[rune]rune{
+	'。': '.', '‐': '-', '‑': '-', '‒': '-', '–': '-', '—': '-', '−': '-',
+	'а': 'a', 'е': 'e', 'о': 'o', 'р': 'p', 'с': 'c', 'х': 'x', 'у': 'y', 'і': 'i', 'ј': 'j', 'ѕ': 's',
+	'А': 'A', 'В': 'B', 'Е': 'E', 'К': 'K', 'М': 'M', 'Н': 'H', 'О': 'O', 'Р': 'P', 'С': 'C', 'Т': 'T', 'Х': 'X',
+	'ο': 'o', 'Α': 'A', 'Β': 'B', 'Ε': 'E', 'Ζ': 'Z', 'Η': 'H', 'Ι': 'I', 'Κ': 'K', 'Μ': 'M', 'Ν': 'N',
+	'Ο': 'O', 'Ρ': 'P', 'Τ': 'T', 'Υ': 'Y', 'Χ': 'X',
+}
+
+// Normalizes obfuscated and disguised text ahead of inspection: compatibility forms per NFKC such as full-width
+// characters, ligatures and circled digits ex: ﬁ to fi, digits of other scripts to ASCII, Cyrillic and Greek letters confusable with ASCII within words mixing scripts,
+// invisible characters removed, spelled-out digits to digits ex: 4111 one one one ... and at/dot obfuscated emails
+// ex: john dot smith at example dot com
+func Normalize(text string) string {
+	normalized, _ := normalizeText(text)
+	return normalized
+}
+
+// Inspects the data normalized from any obfuscated or disguised form, returning the normalized data and its canonical type.
+// Returns Unknown if normalizing does not change the data or its canonical type remains unknown.
+func inspectNormalized(v string, opts Options) (string, CanonicalType) {
+	normalized := Normalize(v)
+	if normalized == v {
+		return v, Unknown
+	}
+	if c := inspectContext(normalized, opts); c != Unknown {
+		return normalized, c
+	}
+	c, _ := inspectString(normalized)
+	return normalized, c
+}
+
+// Normalizes the text the same as Normalize, also returning the byte offset within the text of each byte of the
+// normalized text plus the length of the text, mapping findings in the normalized text back to the text.
+func normalizeText(text string) (string, []int) {
+	normalized, offsets := normalizeRunes(text)
+	normalized, offsets = rewrite(normalized, offsets, validObfuscatedEmail, func(m string) (string, bool) {
+		email := validObfuscatedDot.ReplaceAllString(validObfuscatedAt.ReplaceAllString(m, "@"), ".")
+		c, _ := inspectString(email)
+		return email, c == Email
+	})
+	normalized, offsets = rewrite(normalized, offsets, validSpelledDigits, func(m string) (string, bool) {
+		digits := make([]byte, 0, len(m))
+		spelled := false
+		for _, word := range strings.FieldsFunc(m, func(r rune) bool { return unicode.IsSpace(r) || r == '-' }) {
+			if d, ok := spelledDigits[strings.ToLower(word)]; ok {
+				digits = append(digits, d)
+				spelled = true
+			} else {
+				digits = append(digits, word...)
+			}
+		}
+		if !spelled {
+			return m, true
+		}
+		return string(digits), true
+	})
+	return normalized, offsets
+}
+
+// Normalizes the text per NFKC segment then rune by rune, where confusable letters are only normalized within words
+// containing ASCII letters or digits, so text written in Cyrillic or Greek is left as is.
+func normalizeRunes(text string) (string, []int) {
+	var b strings.Builder
+	offsets := make([]int, 0, len(text)+1)
+	mixed := false
+	for i := 0; i < len(text); {
+		size := norm.NFKC.NextBoundaryInString(text[i:], true)
+		if size <= 0 {
+			size = len(text) - i
+		}
+		segment := text[i : i+size]
+		if r, _ := utf8.DecodeRuneInString(segment); i == 0 || unicode.IsSpace(r) {
+			mixed = hasASCIIAlnum(text[i:])
+		}
+		// a segment of a single byte is ASCII and already normalized
+		if size > 1 {
+			segment = norm.NFKC.String(segment)
+		}
+		for _, r := range segment {
+			n := normalizeRune(r, mixed)
+			if n < 0 {
+				continue
+			}
+			for j := 0; j < utf8.RuneLen(n); j++ {
+				offsets = append(offsets, i)
+			}
+			b.WriteRune(n)
+		}
+		i += size
+	}
+	return b.String(), append(offsets, len(text))
+}
+
+// Normalizes the rune, returning -1 for invisible characters to remove.
+func normalizeRune(r rune, mixed bool) rune {
+	switch {
+	case r < utf8.RuneSelf:
+		return r
+	case r == 0x200B || r == 0x200C || r == 0x200D || r == 0x2060 || r == 0xFEFF || r == 0x00AD:
+		// zero width spaces, joiners and soft hyphen
+		return -1
+	case unicode.IsSpace(r):
+		return ' '
+	}
+	for _, zero := range digitZeros {
+		if r >= zero && r <= zero+9 {
+			return '0' + r - zero
+		}
+	}
+	if c, ok := confusables[r]; ok && (mixed || c < 'A') {
+		return c
+	}
+	return r
+}
+
+// Determines if the word at the start of the text contains an ASCII letter or digit, including full-width forms.
+func hasASCIIAlnum(text string) bool {
+	for _, r := range strings.TrimLeftFunc(text, unicode.IsSpace) {
+		if unicode.IsSpace(r) {
+			break
+		}
+		if r >= 0xFF01 && r <= 0xFF5E {
+			r -= 0xFEE0
+		}
+		if r < utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Replaces each match of the regular expression with its replacement, mapping every byte of a replacement to the
+// offset of its match. Where the replacement is rejected, matching resumes from the rune following the start of the match.
+func rewrite(text string, offsets []int, re *regexp.Regexp, replace func(string) (string, bool)) (string, []int) {
+	var b strings.Builder
+	rewritten := make([]int, 0, len(offsets))
+	last := 0
+	for pos := 0; pos < len(text); {
+		m := re.FindStringIndex(text[pos:])
+		if m == nil {
+			break
+		}
+		start, end := pos+m[0], pos+m[1]
+		replacement, ok := replace(text[start:end])
+		if !ok || start == end {
+			_, size := utf8.DecodeRuneInString(text[start:])
+			pos = start + size
+			continue
+		}
+		b.WriteString(text[last:start])
+		rewritten = append(rewritten, offsets[last:start]...)
+		if replacement == text[start:end] {
+			rewritten = append(rewritten, offsets[start:end]...)
+		} else {
+			for i := 0; i < len(replacement); i++ {
+				rewritten = append(rewritten, offsets[start])
+			}
+		}
+		b.WriteString(replacement)
+		last, pos = end, end
+	}
+	if last == 0 {
+		return text, offsets
+	}
+	b.WriteString(text[last:])
+	return b.String(), append(rewritten, offsets[last:]...)
+}
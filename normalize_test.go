@@ -0,0 +1,75 @@
+package inspectdata
+
+import (
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	normalized := map[string]string{
+		"４１１１ １１１１":                              "4111 1111",
+		"john dot smith at example dot com":      "john.smith@example.com",
+		"jane [at] example [dot] co [dot] uk":    "jane@example.co.uk",
+		"bob(at)example.org":                     "bob@example.org",
+		"4111 one one one one 1111 1111":         "4111111111111111",
+		"nine eight seven-six five":              "98765",
+		"٤١١١ ١١١١":                              "4111 1111",
+		"ехаmple":                                "example",
+		"пример":                                 "пример",
+		"55​5-12‍34":                             "555-1234",
+		"meet me at noon":                        "meet me at noon",
+		"someone at the office":                  "someone at the office",
+		"reach me at john dot smith at mail.com": "reach me at john.smith@mail.com",
+		"2024 12 25":                             "2024 12 25",
+		"alice smith":                            "alice smith",
+		"ﬁle №5":                                 "file No5",
+		"Ⅳ":                                      "IV",
+		"72㎏":                                    "72kg",
+		"①②³":                                    "123",
+		"𝟒𝟏𝟏𝟏":                                   "4111",
+		"cafe\u0301":                             "caf\u00e9",
+	}
+	for v, expected := range normalized {
+		if n := Normalize(v); n != expected {
+			t.Errorf("Normalize should have normalized %q to %q, but got: %q", v, expected, n)
+		}
+	}
+}
+
+func TestNormalizeOffsets(t *testing.T) {
+	text := "id: ４１１ at x"
+	normalized, offsets := normalizeText(text)
+	if normalized != "id: 411 at x" || len(offsets) != len(normalized)+1 {
+		t.Fatalf("normalizeText should have normalized with an offset per byte, but got: %q %v", normalized, offsets)
+	}
+	// each full-width digit is 3 bytes
+	expected := []int{0, 1, 2, 3, 4, 7, 10, 13, 14, 15, 16, 17, 18}
+	for i, offset := range expected {
+		if offsets[i] != offset {
+			t.Errorf("normalizeText should have mapped byte %d to offset %d, but got: %d", i, offset, offsets[i])
+		}
+	}
+}
+
+func TestInspectNormalized(t *testing.T) {
+	inspected := map[string]CanonicalType{
+		"４１１１１１１１１１１１１１１１":                                                 PANVisa,
+		"john dot smith at example dot com":                                Email,
+		"four one one one one one one one one one one one one one one one": PANVisa,
+		"bob@example.соm": Email,
+	}
+	for v, expected := range inspected {
+		datum, err := Inspect(v)
+		if err != nil {
+			t.Error(err)
+		}
+		if datum.Canonical != expected || datum.Normalized == "" || datum.Data != v {
+			t.Errorf("Inspect should have detected canonical type %v for normalized %s, but got: %v %q", expected, v, datum.Canonical, datum.Normalized)
+		}
+	}
+
+	// data identified as is is not normalized
+	datum, _ := Inspect("bob@example.com")
+	if datum.Normalized != "" {
+		t.Errorf("Inspect should not have normalized data identified as is, but got: %q", datum.Normalized)
+	}
+}
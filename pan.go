@@ -0,0 +1,106 @@
+package inspectdata
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Maximum leading and trailing digits a truncated PAN may display per PCI DSS requirement 3.4
+const (
+	maxMaskedLeading  = 6
+	maxMaskedTrailing = 4
+)
+
+// Characters commonly used to mask digits of a PAN, ex: 411111******1111
+const panMaskChars = "*Xx#•"
+
+// Normalizes a PAN written with separators, ex: 4111 1111 1111 1111 or 4111-1111-1111-1111.
+// Groups must be separated by a single space or dash used consistently throughout, the
+// first and middle groups must be 4-6 characters and the last group 1-6 characters.
+// Group characters may be digits or mask characters so masked PANs are normalized too.
+// Returns the string unchanged if it is not a PAN written with separators.
+func normalizePAN(v string) string {
+	sep := ""
+	if strings.Contains(v, " ") {
+		sep = " "
+	}
+	if strings.Contains(v, "-") {
+		if sep != "" {
+			return v
+		}
+		sep = "-"
+	}
+	if sep == "" {
+		return v
+	}
+
+	groups := strings.Split(v, sep)
+	if len(groups) < 2 {
+		return v
+	}
+	for i, g := range groups {
+		size := utf8.RuneCountInString(g)
+		if size > 6 || (i < len(groups)-1 && size < 4) || size < 1 {
+			return v
+		}
+		for _, r := range g {
+			if (r < '0' || r > '9') && !strings.ContainsRune(panMaskChars, r) {
+				return v
+			}
+		}
+	}
+	return strings.Join(groups, "")
+}
+
+// Splits a masked PAN into its visible leading digits, number of masked digits and visible trailing digits.
+// Returns false if the string is not a masked PAN of 13-19 positions with at least 4 visible digits.
+func splitMaskedPAN(v string) (leading string, masked int, trailing string, ok bool) {
+	size := utf8.RuneCountInString(v)
+	if size < minPANLen || size > maxPANLen {
+		return "", 0, "", false
+	}
+
+	i := strings.IndexAny(v, panMaskChars)
+	if i < 0 {
+		return "", 0, "", false
+	}
+	leading = v[:i]
+	rest := v[i:]
+	for rest != "" {
+		r, w := utf8.DecodeRuneInString(rest)
+		if !strings.ContainsRune(panMaskChars, r) {
+			break
+		}
+		masked++
+		rest = rest[w:]
+	}
+	trailing = rest
+
+	if (leading != "" && !isDigits(leading)) || (trailing != "" && !isDigits(trailing)) {
+		return "", 0, "", false
+	}
+	if len(leading)+len(trailing) < 4 {
+		return "", 0, "", false
+	}
+	return leading, masked, trailing, true
+}
+
+// Inspects the string to determine if it is a masked PAN, ex: 411111******1111 or 4111 11** **** 1111.
+func isMaskedPAN(v string) bool {
+	_, _, _, ok := splitMaskedPAN(normalizePAN(v))
+	return ok
+}
+
+// Generates the card details for a masked PAN, denoting if it is truncated per PCI DSS 3.4
+// by displaying at most the first six and last four digits.
+func maskedCardInfo(v string) *CardInfo {
+	pan := normalizePAN(v)
+	leading, _, trailing, _ := splitMaskedPAN(pan)
+
+	info := &CardInfo{PAN: pan}
+	info.Truncated = len(leading) <= maxMaskedLeading && len(trailing) <= maxMaskedTrailing
+	if len(leading) >= 6 {
+		info.BIN = leading[:6]
+	}
+	return info
+}
@@ -0,0 +1,80 @@
+package inspectdata
+
+import (
+	"testing"
+)
+
+func TestNormalizePAN(t *testing.T) {
+	pans := map[string]string{
+		"4111 1111 1111 1111":  "4111111111111111",
+		"4111-1111-1111-1111":  "4111111111111111",
+		"3714 496353 98431":    "371449635398431",
+		"4111 11** **** 1111":  "411111******1111",
+		"4111111111111111":     "4111111111111111",
+		"4111 1111-1111 1111":  "4111 1111-1111 1111",
+		"41 11 11 11 11 11 11": "41 11 11 11 11 11 11",
+		"My string":            "My string",
+	}
+	for v, expected := range pans {
+		if pan := normalizePAN(v); pan != expected {
+			t.Errorf("normalizePAN of %s should be %s, but got: %s", v, expected, pan)
+		}
+	}
+}
+
+func TestInspectSeparatedPAN(t *testing.T) {
+	c, _ := inspectString("4111 1111 1111 1111")
+	if c != PANVisa {
+		t.Errorf("inspectString should have detected canonical type PANVisa, but got: %v", c)
+	}
+	c, _ = inspectString("5500-0055-5555-5559")
+	if c != PANMC {
+		t.Errorf("inspectString should have detected canonical type PANMC, but got: %v", c)
+	}
+	c, _ = inspectString("4111 1111 1111 1112")
+	if c != Unknown {
+		t.Errorf("inspectString should not have detected PAN failing Luhn check, but got: %v", c)
+	}
+
+	datum, err := Inspect("4111-1111-1111-1111")
+	if err != nil {
+		t.Error(err)
+	}
+	if !datum.IsPCI || datum.Card == nil || datum.Card.PAN != "4111111111111111" {
+		t.Errorf("Inspect should have reported normalized PAN, but got: %+v", datum.Card)
+	}
+}
+
+func TestInspectMaskedPAN(t *testing.T) {
+	masked := []string{"411111******1111", "************1111", "4111 11XX XXXX 1111", "4111-11##-####-1111", "41111111••••1111"}
+	for _, v := range masked {
+		if c, _ := inspectString(v); c != MaskedPAN {
+			t.Errorf("inspectString should have detected canonical type MaskedPAN for %s, but got: %v", v, c)
+		}
+	}
+
+	notMasked := []string{"****", "**************", "4111**1111", "4111**1111**1111", "ABCD**********1111"}
+	for _, v := range notMasked {
+		if c, _ := inspectString(v); c == MaskedPAN {
+			t.Errorf("inspectString should not have detected canonical type MaskedPAN for %s", v)
+		}
+	}
+
+	// truncated per PCI DSS first 6 and last 4
+	datum, err := Inspect("4111 11** **** 1111")
+	if err != nil {
+		t.Error(err)
+	}
+	if !datum.Card.Truncated || datum.IsPCI {
+		t.Errorf("Masked PAN showing first 6 and last 4 should be truncated and not PCI, but got: %+v", datum.Card)
+	}
+	if datum.Card.PAN != "411111******1111" || datum.Card.BIN != "411111" {
+		t.Errorf("Masked PAN unexpected normalized card details: %+v", datum.Card)
+	}
+
+	// exposes more than last 4 digits
+	datum, _ = Inspect("411111*****11111")
+	if datum.Card.Truncated || !datum.IsPCI {
+		t.Errorf("Masked PAN showing last 5 should not be truncated and should be PCI, but got: %+v", datum.Card)
+	}
+}
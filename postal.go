@@ -0,0 +1,124 @@
+package inspectdata
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Regular Expressions for postal codes by country, where US, UK and CA have their own canonical types
+const reNLPostalCode = "^[1-9][0-9]{3} ?([A-RT-Z][A-Z]|S[BCE-RT-Z])$"
+const reBRPostalCode = "^[0-9]{5}-[0-9]{3}$"
+const reBRPostalCodeBare = "^[0-9]{8}$"
+const reIEPostalCode = "^([AC-FHKNPRTV-Y][0-9]{2}|D6W) ?[0-9AC-FHKNPRTV-Y]{4}$"
+const reJPPostalCode = "^[0-9]{3}-?[0-9]{4}$"
+const reINPostalCode = "^[1-9][0-9]{2} ?[0-9]{3}$"
+const reFourDigitPostalCode = "^[1-9][0-9]{3}$"
+const reFiveDigitPostalCode = "^[0-9]{5}$"
+const reSixDigitPostalCode = "^[0-9]{6}$"
+const reESPostalCode = "^(0[1-9]|[1-4][0-9]|5[0-2])[0-9]{3}$"
+const reSEPostalCode = "^[1-9][0-9]{2} ?[0-9]{2}$"
+const rePLPostalCode = "^[0-9]{2}-[0-9]{3}$"
+const reAUPostalCode = "^[0-9]{4}$"
+const rePTPostalCode = "^[1-9][0-9]{3}-[0-9]{3}$"
+
+// Postal code format of a country. Distinctive formats are identified from the data alone, while
+// ambiguous formats such as plain digits require context from the inspect options: the country
+// or a postal code field name hint.
+type postalFormat struct {
+	canonical CanonicalType
+	country   string         // Country ISO ALPHA-2 Code ex: DE
+	format    *regexp.Regexp // Distinctive layout identified without context, nil if none
+	bare      *regexp.Regexp // Ambiguous layout only identified with context, nil if none
+	hints     []string       // Field name hints specific to the country's postal codes
+}
+
+// Field name hints indicating a postal code of any country
+var postalHints = []string{"zip", "zipcode", "postcode", "postalcode", "postal"}
+
+// Postal code formats by country in order of precedence when data matches more than one
+var postalFormats = []postalFormat{
+	{USPostalCode, "US", regexp.MustCompile(reUSPostal), nil, nil},
+	{UKPostalCode, "GB", validUKPostalCode, nil, nil},
+	{CAPostalCode, "CA", validCAPostalCode, nil, nil},
+	{PostalCode, "NL", regexp.MustCompile(reNLPostalCode), nil, nil},
+	{PostalCode, "IE", regexp.MustCompile(reIEPostalCode), nil, []string{"eircode"}},
+	{PostalCode, "BR", regexp.MustCompile(reBRPostalCode), regexp.MustCompile(reBRPostalCodeBare), []string{"cep"}},
+	{PostalCode, "DE", nil, regexp.MustCompile(reFiveDigitPostalCode), []string{"plz", "postleitzahl"}},
+	{PostalCode, "FR", nil, regexp.MustCompile(reFiveDigitPostalCode), nil},
+	{PostalCode, "IT", nil, regexp.MustCompile(reFiveDigitPostalCode), []string{"cap"}},
+	{PostalCode, "ES", nil, regexp.MustCompile(reESPostalCode), nil},
+	{PostalCode, "MX", nil, regexp.MustCompile(reFiveDigitPostalCode), nil},
+	{PostalCode, "KR", nil, regexp.MustCompile(reFiveDigitPostalCode), nil},
+	{PostalCode, "JP", nil, regexp.MustCompile(reJPPostalCode), nil},
+	{PostalCode, "IN", nil, regexp.MustCompile(reINPostalCode), []string{"pincode"}},
+	{PostalCode, "CN", nil, regexp.MustCompile(reSixDigitPostalCode), nil},
+	{PostalCode, "SG", nil, regexp.MustCompile(reSixDigitPostalCode), nil},
+	{PostalCode, "SE", nil, regexp.MustCompile(reSEPostalCode), nil},
+	{PostalCode, "PL", nil, regexp.MustCompile(rePLPostalCode), nil},
+	{PostalCode, "PT", nil, regexp.MustCompile(rePTPostalCode), nil},
+	{PostalCode, "AU", nil, regexp.MustCompile(reAUPostalCode), nil},
+	{PostalCode, "AT", nil, regexp.MustCompile(reFourDigitPostalCode), nil},
+	{PostalCode, "BE", nil, regexp.MustCompile(reFourDigitPostalCode), nil},
+	{PostalCode, "CH", nil, regexp.MustCompile(reFourDigitPostalCode), nil},
+	{PostalCode, "DK", nil, regexp.MustCompile(reFourDigitPostalCode), nil},
+	{PostalCode, "NO", nil, regexp.MustCompile(reFourDigitPostalCode), nil},
+}
+
+// Finds the postal code format of the data, restricted to the country of the options or of a country
+// specific field name hint (ex: plz) if any. Ambiguous formats are only considered when the options
+// specify a country or a postal code field name.
+func findPostalCode(v string, opts Options) (postalFormat, bool) {
+	if opts.Country == "" {
+		for _, p := range postalFormats {
+			if hasFieldHint(opts.Field, p.hints...) {
+				opts.Country = p.country
+				break
+			}
+		}
+	}
+	context := opts.Country != "" || hasFieldHint(opts.Field, postalHints...)
+	for _, p := range postalFormats {
+		if opts.Country != "" && !strings.EqualFold(p.country, opts.Country) {
+			continue
+		}
+		if p.format != nil && p.format.MatchString(v) {
+			return p, true
+		}
+		if context && p.bare != nil && p.bare.MatchString(v) {
+			return p, true
+		}
+	}
+	return postalFormat{}, false
+}
+
+// Inspects the string to determine if it is a postal code with a distinctive format.
+// Returns Unknown if the string is not such a postal code.
+func inspectPostalCode(v string) CanonicalType {
+	if p, ok := findPostalCode(v, Options{}); ok {
+		return p.canonical
+	}
+	return Unknown
+}
+
+// Inspects the string using the country or field name of the options to determine if it is a postal code
+// of the expected country. Returns Unknown if the options do not indicate a postal code for the data.
+func inspectPostalContext(v string, opts Options) CanonicalType {
+	if opts.Country == "" && opts.Field == "" {
+		return Unknown
+	}
+	if p, ok := findPostalCode(v, opts); ok {
+		return p.canonical
+	}
+	return Unknown
+}
+
+// Determines the country of the postal code, preferring the country of the options.
+func postalCountry(v string, opts Options) string {
+	if p, ok := findPostalCode(v, opts); ok {
+		return p.country
+	}
+	if p, ok := findPostalCode(v, Options{}); ok {
+		return p.country
+	}
+	return ""
+}
@@ -0,0 +1,75 @@
+package inspectdata
+
+import (
+	"testing"
+)
+
+func TestInspectPostalCode(t *testing.T) {
+	codes := map[string]string{
+		"12345":      "US",
+		"12345-6789": "US",
+		"SW1A 1AA":   "GB",
+		"K1A 0B1":    "CA",
+		"1011 AB":    "NL",
+		"3511AX":     "NL",
+		"D02 X285":   "IE",
+		"01310-100":  "BR",
+	}
+	for v, country := range codes {
+		datum, err := Inspect(v)
+		if err != nil {
+			t.Error(err)
+		}
+		if inspectPostalCode(v) == Unknown || datum.Country != country {
+			t.Errorf("Inspect should have detected postal code of %s for %s, but got: %+v", country, v, datum)
+		}
+	}
+
+	// NL never issues SA, SD and SS, ambiguous formats require context
+	invalid := []string{"1011 SS", "0123 AB", "01310100", "2000", "110 001"}
+	for _, v := range invalid {
+		if c := inspectPostalCode(v); c != Unknown {
+			t.Errorf("inspectPostalCode should not have detected postal code for %s, but got: %v", v, c)
+		}
+	}
+}
+
+func TestInspectPostalCodeContext(t *testing.T) {
+	contexts := []struct {
+		data     string
+		opts     Options
+		expected CanonicalType
+		country  string
+	}{
+		{"10115", Options{Country: "DE"}, PostalCode, "DE"},
+		{"75008", Options{Country: "fr"}, PostalCode, "FR"},
+		{"12345", Options{Country: "US"}, USPostalCode, "US"},
+		{"2000", Options{Country: "AU"}, PostalCode, "AU"},
+		{"2000", Options{Field: "postcode"}, PostalCode, "AU"},
+		{"100-0001", Options{Country: "JP"}, PostalCode, "JP"},
+		{"110 001", Options{Field: "pincode"}, PostalCode, "IN"},
+		{"01310100", Options{Field: "cep"}, PostalCode, "BR"},
+		{"10115", Options{Field: "plz"}, PostalCode, "DE"},
+		{"00-950", Options{Field: "zip_code"}, PostalCode, "PL"},
+		{"12345", Options{Field: "zip"}, USPostalCode, "US"},
+	}
+	for _, ctx := range contexts {
+		datum, err := InspectWithOptions(ctx.data, ctx.opts)
+		if err != nil {
+			t.Error(err)
+		}
+		if datum.Canonical != ctx.expected || datum.Country != ctx.country {
+			t.Errorf("InspectWithOptions should have detected %v of %s for %s with %+v, but got: %+v", ctx.expected, ctx.country, ctx.data, ctx.opts, datum)
+		}
+	}
+
+	// country restricts the formats, ex: Spanish postal codes only range to 52
+	datum, _ := InspectWithOptions("53001", Options{Country: "ES"})
+	if datum.Canonical == PostalCode {
+		t.Errorf("InspectWithOptions should not have detected PostalCode for ES outside provinces, but got: %+v", datum)
+	}
+	datum, _ = InspectWithOptions("1234", Options{Field: "card_cvv", Country: "AU"})
+	if datum.Canonical != CardCVV {
+		t.Errorf("InspectWithOptions should have preferred CardCVV field context over postal code, but got: %+v", datum)
+	}
+}
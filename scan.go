@@ -0,0 +1,116 @@
+package inspectdata
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Maximum number of whitespace separated tokens of a single finding when scanning text
+// ex: 123 Main St Apt 4B, Springfield, IL 62704
+const maxScanTokens = 10
+
+// Punctuation trimmed from the ends of candidate findings when scanning text
+const scanTrimChars = ".,;:!?'\"()[]{}<>"
+
+// Lowercase words that neither start nor end a finding, pruning candidates of the scanned text ahead of inspection
+var scanStopWords = stringSet(
+	"a an and are as at be but by for from has have he her his i if in is it its me my no not of on or our please",
+	"she so than that the their them then there they this to us was we were with you your",
+)
+
+// Token of the scanned text as its byte offsets
+type scanToken struct {
+	start int
+	end   int
+}
+
+// Scans free text for sensitive data (PII, PCI, PHI, financial or secret), inspecting the text normalized from
+// obfuscated and disguised forms. Returns the findings in order with their byte offsets within the text, where the
+// data of each finding is the original text and any normalized form is given by the Normalized field.
+//
+// Example Usage
+//  findings := Scan("reach me at john dot smith at example dot com")
+//  fmt.Printf("%v %s %d %d\n", findings[0].Canonical, findings[0].Normalized, findings[0].Start, findings[0].End)
+//  Email john.smith@example.com 12 45
+func Scan(text string) []Datum {
+	normalized, offsets := normalizeText(text)
+	tokens := scanTokens(normalized)
+
+	var findings []Datum
+	for i := 0; i < len(tokens); {
+		n := len(tokens) - i
+		if n > maxScanTokens {
+			n = maxScanTokens
+		}
+		found := 0
+		for ; n > 0 && found == 0; n-- {
+			if isScanStopWord(normalized, tokens[i]) || isScanStopWord(normalized, tokens[i+n-1]) {
+				continue
+			}
+			start, end := trimScanCandidate(normalized, tokens[i].start, tokens[i+n-1].end)
+			if start >= end {
+				continue
+			}
+			datum, ok := scanCandidate(normalized[start:end], n > 1)
+			if !ok {
+				continue
+			}
+			datum.Start, datum.End = offsets[start], offsets[end]
+			datum.Data = text[datum.Start:datum.End]
+			if datum.Normalized == "" && normalized[start:end] != datum.Data {
+				datum.Normalized = normalized[start:end]
+			}
+			findings = append(findings, datum)
+			found = n
+		}
+		if found == 0 {
+			found = 1
+		}
+		i += found
+	}
+	return findings
+}
+
+// Inspects the candidate of the scanned text, where entropy based secrets are only identified within a single token.
+// Returns false if the candidate is not sensitive data.
+func scanCandidate(v string, multiple bool) (Datum, bool) {
+	datum, err := Inspect(v)
+	return datum, err == nil && isSensitive(datum) && !(multiple && datum.Canonical == Secret)
+}
+
+// Splits the text into whitespace separated tokens.
+func scanTokens(text string) []scanToken {
+	var tokens []scanToken
+	start := -1
+	for i, r := range text {
+		if unicode.IsSpace(r) {
+			if start >= 0 {
+				tokens = append(tokens, scanToken{start, i})
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, scanToken{start, len(text)})
+	}
+	return tokens
+}
+
+// Determines if the token of the scanned text is a stop word once trimmed of punctuation.
+func isScanStopWord(text string, token scanToken) bool {
+	start, end := trimScanCandidate(text, token.start, token.end)
+	return scanStopWords[text[start:end]]
+}
+
+// Trims punctuation from the ends of the candidate returning its trimmed byte offsets.
+func trimScanCandidate(text string, start int, end int) (int, int) {
+	for start < end && strings.IndexByte(scanTrimChars, text[start]) >= 0 {
+		start++
+	}
+	for end > start && strings.IndexByte(scanTrimChars, text[end-1]) >= 0 {
+		end--
+	}
+	return start, end
+}
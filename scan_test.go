@@ -0,0 +1,56 @@
+package inspectdata
+
+import (
+	"testing"
+)
+
+func TestScan(t *testing.T) {
+	text := "Reach me at john dot smith at example dot com, card ４１１１ one one one one 1111 1111 (exp soon). SSN: 867-53-0999."
+	findings := Scan(text)
+	expected := []struct {
+		canonical  CanonicalType
+		data       string
+		normalized string
+	}{
+		{Email, "john dot smith at example dot com", "john.smith@example.com"},
+		{PANVisa, "４１１１ one one one one 1111 1111", "4111111111111111"},
+		{SSN, "867-53-0999", ""},
+	}
+	if len(findings) != len(expected) {
+		t.Fatalf("Scan should have found %d findings, but got: %+v", len(expected), findings)
+	}
+	for i, e := range expected {
+		f := findings[i]
+		if f.Canonical != e.canonical || f.Data != e.data || f.Normalized != e.normalized || text[f.Start:f.End] != e.data {
+			t.Errorf("Scan should have found %v %q normalized %q, but got: %v %q normalized %q at %d:%d",
+				e.canonical, e.data, e.normalized, f.Canonical, f.Data, f.Normalized, f.Start, f.End)
+		}
+	}
+}
+
+func TestScanSpacedPAN(t *testing.T) {
+	text := "Card number 4111 1111 1111 1111 on file"
+	findings := Scan(text)
+	if len(findings) != 1 || findings[0].Canonical != PANVisa || findings[0].Data != "4111 1111 1111 1111" {
+		t.Errorf("Scan should have found the spaced PAN, but got: %+v", findings)
+	}
+	if findings := Scan("nothing sensitive to see here"); len(findings) != 0 {
+		t.Errorf("Scan should not have found findings, but got: %+v", findings)
+	}
+}
+
+func TestScanStopWords(t *testing.T) {
+	// candidates starting or ending in stop words are pruned without losing the finding between them
+	findings := Scan("ask for John Smith at the front desk")
+	if len(findings) != 1 || findings[0].Canonical != PersonName || findings[0].Data != "John Smith" {
+		t.Errorf("Scan should have found the name between stop words, but got: %+v", findings)
+	}
+}
+
+func BenchmarkScan(b *testing.B) {
+	text := "Hi team, please reach John Smith at john dot smith at example dot com or 415-555-0132 about the invoice. " +
+		"Card 4111 1111 1111 1111 expires soon and the SSN on file is 867-53-0999, shipped to 123 Main St, Springfield, IL 62704."
+	for i := 0; i < b.N; i++ {
+		Scan(text)
+	}
+}
@@ -0,0 +1,42 @@
+package inspectdata
+
+import (
+	"regexp"
+)
+
+// Regular Expressions for United Kingdom identifiers and postcodes
+// National Insurance number letters exclude D, F, I, Q, U and V, with O also excluded as the second letter.
+const reUKNINO = "^[A-CEGHJ-PR-TW-Z][A-CEGHJ-NPR-TW-Z] ?[0-9]{2} ?[0-9]{2} ?[0-9]{2} ?[A-D]$"
+const reUKNHS = "^[0-9]{3}[ -]?[0-9]{3}[ -]?[0-9]{4}$" // NNN NNN NNNN is also the layout of North American phone numbers
+const reUKPostalCode = "^(GIR ?0AA|[A-PR-UWYZ]([0-9]{1,2}|[A-HK-Y][0-9]{1,2}|[0-9][A-HJKPS-UW]|[A-HK-Y][0-9][ABEHMNPRV-Y]) ?[0-9][ABD-HJLNP-UW-Z]{2})$"
+
+var validUKPostalCode = regexp.MustCompile(reUKPostalCode)
+
+// National Insurance number prefixes that are never allocated
+var invalidNINOPrefixes = map[string]bool{"BG": true, "GB": true, "KN": true, "NK": true, "NT": true, "TN": true, "ZZ": true}
+
+// United Kingdom identifiers flagged PII, NHS number additionally flagged PHI
+var ukIdentifiers = []identifier{
+	{UKNINO, "GB", regexp.MustCompile(reUKNINO), nil, []string{"nino", "nationalinsurance"}, isUKNINO, false, false},
+	{UKNHS, "GB", nil, regexp.MustCompile(reUKNHS), []string{"nhs", "nhsnumber", "health"}, isUKNHS, true, false},
+}
+
+// Validates a UK National Insurance number does not use an unallocated prefix.
+func isUKNINO(v string) bool {
+	return !invalidNINOPrefixes[v[:2]]
+}
+
+// Validates the mod 11 check digit of a UK NHS number: the first 9 digits are weighted 10 to 2
+// and the check digit is 11 minus the sum mod 11, where 11 becomes 0 and 10 is invalid.
+func isUKNHS(v string) bool {
+	v = removeSeparators(v, " -")
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += int(v[i]-'0') * (10 - i)
+	}
+	check := 11 - sum%11
+	if check == 11 {
+		check = 0
+	}
+	return check != 10 && check == int(v[9]-'0')
+}
@@ -0,0 +1,79 @@
+package inspectdata
+
+import (
+	"testing"
+)
+
+func TestInspectUKNINO(t *testing.T) {
+	valid := []string{"AB123456C", "AB 12 34 56 C", "JG103759A"}
+	for _, v := range valid {
+		if c, _ := inspectString(v); c != UKNINO {
+			t.Errorf("inspectString should have detected canonical type UKNINO for %s, but got: %v", v, c)
+		}
+	}
+
+	// invalid first letter D, second letter O, unallocated prefix GB or suffix E
+	invalid := []string{"DA123456C", "AO123456C", "GB123456A", "AB123456E"}
+	for _, v := range invalid {
+		if c, _ := inspectString(v); c == UKNINO {
+			t.Errorf("inspectString should not have detected canonical type UKNINO for %s", v)
+		}
+	}
+
+	datum, err := Inspect("AB123456C")
+	if err != nil {
+		t.Error(err)
+	}
+	if !datum.IsPII || datum.IsPHI || datum.Country != "GB" {
+		t.Errorf("UK National Insurance number should be denoted as PII for GB, but got: %+v", datum)
+	}
+}
+
+func TestInspectUKNHS(t *testing.T) {
+	valid := map[string]Options{
+		"943 476 5919": {Country: "GB"},
+		"943-476-5919": {Country: "gb"},
+		"9434765919":   {Field: "nhs_number"},
+		"401 023 2137": {Field: "HealthNo"},
+	}
+	for v, opts := range valid {
+		datum, err := InspectWithOptions(v, opts)
+		if err != nil {
+			t.Error(err)
+		}
+		if datum.Canonical != UKNHS || !datum.IsPII || !datum.IsPHI || datum.Country != "GB" {
+			t.Errorf("NHS number %s should be denoted as PII and PHI with %+v, but got: %+v", v, opts, datum)
+		}
+	}
+	datum, _ := InspectWithOptions("943 476 5918", Options{Country: "GB"})
+	if datum.Canonical == UKNHS {
+		t.Errorf("InspectWithOptions should not have detected canonical type UKNHS with invalid check digit")
+	}
+
+	// NNN NNN NNNN is also the layout of a North American phone number ex: 415-555-0008 passes the mod 11 check
+	for _, v := range []string{"943 476 5919", "943-476-5919", "9434765919", "415-555-0008"} {
+		if c, _ := inspectString(v); c == UKNHS {
+			t.Errorf("inspectString should not have detected canonical type UKNHS for %s without context", v)
+		}
+	}
+	datum, _ = InspectWithOptions("415-555-0008", Options{Country: "US", Field: "phone"})
+	if datum.Canonical == UKNHS || datum.IsPHI {
+		t.Errorf("InspectWithOptions should not have detected a US phone number as UKNHS, but got: %+v", datum)
+	}
+}
+
+func TestInspectUKPostalCode(t *testing.T) {
+	valid := []string{"SW1A 1AA", "M1 1AE", "B33 8TH", "CR2 6XH", "DN55 1PT", "W1A 0AX", "EC1A 1BB", "EC1A1BB", "GIR 0AA"}
+	for _, v := range valid {
+		if c, _ := inspectString(v); c != UKPostalCode {
+			t.Errorf("inspectString should have detected canonical type UKPostalCode for %s, but got: %v", v, c)
+		}
+	}
+
+	invalid := []string{"QA1 1AA", "SW1A 1CA", "SW1A", "1AA SW1"}
+	for _, v := range invalid {
+		if c, _ := inspectString(v); c == UKPostalCode {
+			t.Errorf("inspectString should not have detected canonical type UKPostalCode for %s", v)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package inspectdata
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Regular Expressions for URLs, domain names and JSON Web Tokens
+const reURL = "^[a-zA-Z][a-zA-Z0-9+.-]*://[^\\s]+$"
+const reFQDN = "(?i)^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\\.)+[a-z]{2,63}\\.?$"
+const reHostname = "(?i)^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$"
+const reJWT = "^[A-Za-z0-9_-]{2,}\\.[A-Za-z0-9_-]{2,}\\.(?:[A-Za-z0-9_-]{2,})?$"
+
+var validURL = regexp.MustCompile(reURL)
+var validFQDN = regexp.MustCompile(reFQDN)
+var validHostname = regexp.MustCompile(reHostname)
+var validJWT = regexp.MustCompile(reJWT)
+
+// Field name hints indicating a hostname or domain name
+var hostnameHints = []string{"host", "hostname", "server", "servername", "domain", "fqdn"}
+
+// URL components parsed per RFC 3986
+type URLInfo struct {
+	Scheme      string // Scheme ex: https
+	Host        string // Hostname or IP address without port
+	Port        string // Port if explicitly given ex: 8080
+	Path        string // Path unescaped ex: /users/bob@example.com
+	Username    string // Username of any userinfo ex: user of user:password@
+	HasPassword bool   // Denotes if the userinfo embeds a password ex: user:password@
+}
+
+// Inspects the string to determine if it is a URL with a host or a JSON Web Token (JWS compact serialization), including
+// an unsecured JWT of alg none with an empty signature as it still carries bearer claims.
+// Returns Unknown if the string is neither.
+func inspectURL(v string) CanonicalType {
+	if validURL.MatchString(v) {
+		if u, err := url.Parse(v); err == nil && u.Host != "" {
+			return URL
+		}
+	}
+	if validJWT.MatchString(v) && isJWT(v) {
+		return JWT
+	}
+	return Unknown
+}
+
+// Inspects the string to determine if it is a fully qualified domain name ending with a public suffix, where a name of
+// two labels must end in the root dot ex: example.com. as nearly every short TLD is also a file extension, making a
+// name such as notes.app or script.pl as likely a filename. Returns Unknown if the string is not a FQDN.
+func inspectFQDN(v string) CanonicalType {
+	if !isDomainName(v) || (strings.Count(v, ".") == 1 && !strings.HasSuffix(v, ".")) {
+		return Unknown
+	}
+	return FQDN
+}
+
+// Inspects the string using the field name of the options to determine if it is a single label hostname ex: db01,
+// or a domain name of two labels ex: example.com. Returns Unknown if the options do not indicate a hostname for the data.
+func inspectHostnameContext(v string, opts Options) CanonicalType {
+	if !hasFieldHint(opts.Field, hostnameHints...) {
+		return Unknown
+	}
+	if validHostname.MatchString(v) && !isDigits(v) {
+		return Hostname
+	}
+	if isDomainName(v) {
+		return FQDN
+	}
+	return Unknown
+}
+
+// Determines if the string is a domain name of at least two labels ending with a public suffix, ex: not John.Smith
+func isDomainName(v string) bool {
+	return len(v) <= 253 && validFQDN.MatchString(v) && publicSuffix(strings.TrimSuffix(v, ".")) != ""
+}
+
+// Determines if the header and payload of the token decode from base64url to JSON objects with the header declaring its alg.
+func isJWT(v string) bool {
+	parts := strings.Split(v, ".")
+	var header, payload map[string]interface{}
+	if !decodeJWTPart(parts[0], &header) || !decodeJWTPart(parts[1], &payload) {
+		return false
+	}
+	_, ok := header["alg"]
+	return ok
+}
+
+// Decodes a base64url JWT part with or without padding into the JSON object.
+func decodeJWTPart(part string, obj *map[string]interface{}) bool {
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(part, "="))
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(data, obj) == nil && *obj != nil
+}
+
+// Parses the URL components, where the data has already been inspected as a URL.
+func urlInfo(v string) *URLInfo {
+	u, err := url.Parse(v)
+	if err != nil {
+		return nil
+	}
+	info := &URLInfo{Scheme: strings.ToLower(u.Scheme), Host: u.Hostname(), Port: u.Port(), Path: u.Path}
+	if u.User != nil {
+		info.Username = u.User.Username()
+		_, info.HasPassword = u.User.Password()
+	}
+	return info
+}
+
+// Recursively inspects the username, path segments and query parameter values of the URL, where query parameter
+// names are the field name context. Returns the findings considered sensitive (PII, PCI, PHI, financial or secret).
+func urlFindings(v string) []Datum {
+	u, err := url.Parse(v)
+	if err != nil {
+		return nil
+	}
+	var findings []Datum
+	add := func(value string, field string) {
+		if value == "" {
+			return
+		}
+		datum, err := InspectWithOptions(value, Options{Field: field})
+		if err != nil || !isSensitive(datum) {
+			return
+		}
+		datum.Field = field
+		findings = append(findings, datum)
+	}
+	if u.User != nil {
+		add(u.User.Username(), "username")
+	}
+	for _, segment := range strings.Split(u.Path, "/") {
+		add(segment, "path")
+	}
+	query := u.Query()
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, value := range query[key] {
+			add(value, key)
+		}
+	}
+	return findings
+}
+
+// Determines if the datum is considered sensitive: PII, PCI, PHI, financial or secret.
+func isSensitive(datum Datum) bool {
+	return datum.IsPII || datum.IsPCI || datum.IsPHI || datum.IsFinancial || datum.IsSecret
+}
+
+// Denotes the datum as sensitive in the same ways as any of its findings.
+func mergeFindings(datum *Datum) {
+	for _, f := range datum.Findings {
+		datum.IsPII = datum.IsPII || f.IsPII
+		datum.IsPCI = datum.IsPCI || f.IsPCI
+		datum.IsPHI = datum.IsPHI || f.IsPHI
+		datum.IsFinancial = datum.IsFinancial || f.IsFinancial
+		datum.IsSecret = datum.IsSecret || f.IsSecret
+	}
+}
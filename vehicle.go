@@ -0,0 +1,167 @@
+package inspectdata
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Regular Expressions for vehicle identification numbers and license plates
+// VIN characters per ISO 3779 exclude I, O and Q, with the model year also excluding U, Z and 0.
+const reVIN = "^[A-HJ-NPR-Z0-9]{9}[A-HJ-NPR-TV-Y1-9][A-HJ-NPR-Z0-9]{7}$"
+
+var validVIN = regexp.MustCompile(reVIN)
+
+// VIN transliterated values of letters A to Z for the North American check digit, where I, O and Q are never used
+const vinLetterValues = "12345678.12345.7.923456789"
+
+// VIN check digit weights by position, where the check digit itself at position 9 is weighted 0
+var vinWeights = [17]int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+
+// VIN model year codes of a 30 year cycle starting 1980 or 2010
+const vinYearCodes = "ABCDEFGHJKLMNPRSTVWXY123456789"
+
+// Field name hints indicating vehicle identifiers
+var vinHints = []string{"vin", "chassisnumber", "vehicleidentificationnumber"}
+var plateHints = []string{"plate", "licenseplate", "licenceplate", "numberplate", "regno", "registrationnumber", "kennzeichen", "immatriculation", "targa", "matricula"}
+
+// Vehicle details decoded from a VIN
+type VehicleInfo struct {
+	VIN          string // VIN uppercase
+	WMI          string // World Manufacturer Identifier: leading 3 characters
+	Manufacturer string // Manufacturer of well known WMIs ex: Ford
+	Country      string // Manufacturing country ISO ALPHA-2 Code decoded from the WMI ex: US
+	ModelYear    int    // Model year decoded from position 10 ex: 2019
+}
+
+// Manufacturers of well known World Manufacturer Identifiers
+var wmiManufacturers = map[string]string{
+	"1C4": "Chrysler", "1FA": "Ford", "1FT": "Ford", "1G1": "Chevrolet", "1GC": "Chevrolet", "1HG": "Honda",
+	"1J4": "Jeep", "1M8": "Motor Coach Industries", "1N4": "Nissan", "2HG": "Honda", "2T1": "Toyota",
+	"3VW": "Volkswagen", "4T1": "Toyota", "5YJ": "Tesla", "JHM": "Honda", "JN1": "Nissan", "JTD": "Toyota",
+	"KMH": "Hyundai", "KNA": "Kia", "SAL": "Land Rover", "SAJ": "Jaguar", "TMB": "Skoda", "VF1": "Renault",
+	"VF3": "Peugeot", "WAU": "Audi", "WBA": "BMW", "WDB": "Mercedes-Benz", "WDD": "Mercedes-Benz",
+	"WP0": "Porsche", "WVW": "Volkswagen", "YV1": "Volvo", "ZFA": "Fiat",
+}
+
+// License plate format of a country
+type plateFormat struct {
+	country string         // Country ISO ALPHA-2 Code ex: DE
+	format  *regexp.Regexp // Plate format uppercase
+}
+
+// License plate formats with the distinctive EU formats ahead of the generic US state format
+var plateFormats = []plateFormat{
+	{"DE", regexp.MustCompile("^[A-ZÄÖÜ]{1,3}-[A-Z]{1,2} ?[1-9][0-9]{0,3}[EH]?$")},
+	{"FR", regexp.MustCompile("^[A-HJ-NP-TV-Z]{2}-[0-9]{3}-[A-HJ-NP-TV-Z]{2}$")},
+	{"IT", regexp.MustCompile("^[A-HJ-NPR-TV-Z]{2} ?[0-9]{3} ?[A-HJ-NPR-TV-Z]{2}$")},
+	{"ES", regexp.MustCompile("^[0-9]{4} ?[BCDFGHJKLMNPRSTVWXYZ]{3}$")},
+	{"GB", regexp.MustCompile("^[A-Z]{2}[0-9]{2} ?[A-Z]{3}$")},
+	{"BE", regexp.MustCompile("^[1-9]-[A-Z]{3}-[0-9]{3}$")},
+	{"NL", regexp.MustCompile("^[A-Z0-9]{1,3}-[A-Z0-9]{2,3}-[A-Z0-9]{1,2}$")},
+	{"PL", regexp.MustCompile("^[A-Z]{2,3} [0-9A-Z]{4,5}$")},
+	{"US", regexp.MustCompile("^[A-Z0-9]{1,4}[ -]?[A-Z0-9]{1,4}$")},
+}
+
+// Calculates the North American check digit of a VIN: the transliterated characters weighted by position, mod 11,
+// where 10 is written as X.
+func vinCheckDigit(vin string) byte {
+	sum := 0
+	for i := 0; i < len(vin); i++ {
+		value := int(vin[i] - '0')
+		if vin[i] >= 'A' {
+			value = int(vinLetterValues[vin[i]-'A'] - '0')
+		}
+		sum += value * vinWeights[i]
+	}
+	if sum%11 == 10 {
+		return 'X'
+	}
+	return byte('0' + sum%11)
+}
+
+// Inspects the string to determine if it is a VIN of the ISO 3779 character set with a valid North American check digit.
+// Returns Unknown if the string is not such a VIN.
+func inspectVIN(v string) CanonicalType {
+	if validVIN.MatchString(v) && vinCheckDigit(v) == v[8] {
+		return VIN
+	}
+	return Unknown
+}
+
+// Inspects the string using the field name of the options to determine if it is a VIN of the ISO 3779 character set
+// without a North American check digit (ex: European manufacturers), or a license plate of the country of the options
+// if any. Returns Unknown if the options do not indicate a vehicle identifier for the data.
+func inspectVehicleContext(v string, opts Options) CanonicalType {
+	switch {
+	case hasFieldHint(opts.Field, vinHints...) && validVIN.MatchString(strings.ToUpper(v)):
+		return VIN
+	case hasFieldHint(opts.Field, plateHints...) && plateCountry(v, opts) != "":
+		return LicensePlate
+	}
+	return Unknown
+}
+
+// Determines the country of the license plate format matching the data, restricted to the country of the options if any.
+func plateCountry(v string, opts Options) string {
+	v = strings.ToUpper(v)
+	for _, p := range plateFormats {
+		if opts.Country != "" && !strings.EqualFold(p.country, opts.Country) {
+			continue
+		}
+		if p.format.MatchString(v) && strings.ContainsAny(v, "0123456789") {
+			return p.country
+		}
+	}
+	return ""
+}
+
+// Decodes the manufacturer, country and model year of the VIN.
+func vehicleInfo(v string) *VehicleInfo {
+	vin := strings.ToUpper(v)
+	info := &VehicleInfo{VIN: vin, WMI: vin[:3], Manufacturer: wmiManufacturers[vin[:3]], Country: wmiCountry(vin[:2])}
+	if year := strings.IndexByte(vinYearCodes, vin[9]); year >= 0 {
+		// North American passenger vehicles use a digit at position 7 for 1980-2009 and a letter for 2010-2039
+		info.ModelYear = 1980 + year
+		if vin[6] < '0' || vin[6] > '9' {
+			info.ModelYear += 30
+		}
+	}
+	return info
+}
+
+// Decodes the manufacturing country ISO ALPHA-2 Code of the leading 2 WMI characters, or empty if unassigned.
+func wmiCountry(region string) string {
+	switch c := region[0]; {
+	case c == '1' || c == '4' || c == '5':
+		return "US"
+	case c == '2':
+		return "CA"
+	case c == '3' && region[1] >= 'A' && region[1] <= 'W':
+		return "MX"
+	case c == '6':
+		return "AU"
+	case c == '9' && region[1] <= 'E':
+		return "BR"
+	case c == 'J':
+		return "JP"
+	case c == 'K' && region[1] >= 'L' && region[1] <= 'R':
+		return "KR"
+	case c == 'L':
+		return "CN"
+	case c == 'S' && region[1] <= 'M':
+		return "GB"
+	case c == 'T' && region[1] >= 'J' && region[1] <= 'P':
+		return "CZ"
+	case c == 'V' && region[1] >= 'F' && region[1] <= 'R':
+		return "FR"
+	case c == 'V' && region[1] >= 'S' && region[1] <= 'W':
+		return "ES"
+	case c == 'W':
+		return "DE"
+	case c == 'Y' && region[1] >= 'S' && region[1] <= 'W':
+		return "SE"
+	case c == 'Z' && region[1] <= 'R':
+		return "IT"
+	}
+	return ""
+}
@@ -0,0 +1,69 @@
+package inspectdata
+
+import (
+	"testing"
+)
+
+func TestInspectVIN(t *testing.T) {
+	valid := []string{"1M8GDM9AXKP042788", "1HGCM82633A004352", "JHMCM56557C404453", "3VWFE21C04M000001"}
+	for _, v := range valid {
+		datum, err := Inspect(v)
+		if err != nil {
+			t.Error(err)
+		}
+		if datum.Canonical != VIN || !datum.IsPII || datum.Vehicle == nil {
+			t.Errorf("Inspect should have detected PII VIN with vehicle details for %s, but got: %+v", v, datum)
+		}
+	}
+
+	// invalid check digit, I, O and Q never used, model year never U, Z or 0
+	invalid := []string{"1M8GDM9A1KP042788", "1HGCM82633A00435I", "1HGCM8263OA004352", "1HGCM82633Z004352", "WVWZZZ1JZXW000001"}
+	for _, v := range invalid {
+		if c, _ := inspectString(v); c == VIN {
+			t.Errorf("inspectString should not have detected canonical type VIN for %s", v)
+		}
+	}
+}
+
+func TestVehicleInfo(t *testing.T) {
+	vehicles := []VehicleInfo{
+		{VIN: "1HGCM82633A004352", WMI: "1HG", Manufacturer: "Honda", Country: "US", ModelYear: 2003},
+		{VIN: "1M8GDM9AXKP042788", WMI: "1M8", Manufacturer: "Motor Coach Industries", Country: "US", ModelYear: 1989},
+		{VIN: "3VWFE21C04M000001", WMI: "3VW", Manufacturer: "Volkswagen", Country: "MX", ModelYear: 2004},
+		{VIN: "5YJ3E1EA7KF317000", WMI: "5YJ", Manufacturer: "Tesla", Country: "US", ModelYear: 2019},
+		{VIN: "WVWZZZ1JZXW000001", WMI: "WVW", Manufacturer: "Volkswagen", Country: "DE", ModelYear: 1999},
+	}
+	for _, expected := range vehicles {
+		if info := vehicleInfo(expected.VIN); *info != expected {
+			t.Errorf("vehicleInfo should have decoded %+v, but got: %+v", expected, *info)
+		}
+	}
+}
+
+func TestInspectVehicleContext(t *testing.T) {
+	contexts := []struct {
+		data     string
+		opts     Options
+		expected CanonicalType
+		country  string
+	}{
+		{"WVWZZZ1JZXW000001", Options{Field: "vin"}, VIN, ""},
+		{"B-MW 1234", Options{Field: "license_plate"}, LicensePlate, "DE"},
+		{"AB-123-CD", Options{Field: "plate"}, LicensePlate, "FR"},
+		{"1234 BCD", Options{Field: "matricula"}, LicensePlate, "ES"},
+		{"AB12 CDE", Options{Field: "reg_no"}, LicensePlate, "GB"},
+		{"7ABC123", Options{Field: "plate_number"}, LicensePlate, "US"},
+		{"ABC 1234", Options{Field: "licensePlate", Country: "us"}, LicensePlate, "US"},
+		{"ABC 1234", Options{Field: "licensePlate", Country: "FR"}, Unknown, ""},
+		{"VANITY", Options{Field: "plate"}, Unknown, ""},
+	}
+	for _, ctx := range contexts {
+		datum, _ := InspectWithOptions(ctx.data, ctx.opts)
+		if datum.Canonical != ctx.expected && !(ctx.expected == Unknown && datum.Canonical != LicensePlate) {
+			t.Errorf("InspectWithOptions should have detected %v for %s with %+v, but got: %+v", ctx.expected, ctx.data, ctx.opts, datum)
+		}
+		if ctx.expected == LicensePlate && (datum.Country != ctx.country || !datum.IsPII) {
+			t.Errorf("InspectWithOptions should have detected PII license plate of %s for %s, but got: %+v", ctx.country, ctx.data, datum)
+		}
+	}
+}
@@ -0,0 +1,276 @@
+package inspectdata
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/bits"
+	"regexp"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// Regular Expressions for cryptocurrency wallet addresses
+const reBase58Address = "^[1-9A-HJ-NP-Za-km-z]{25,35}$"
+const reEthereumAddress = "^0x[0-9a-fA-F]{40}$"
+const reMoneroAddress = "^[48][1-9A-HJ-NP-Za-km-z]{94}([1-9A-HJ-NP-Za-km-z]{11})?$" // standard/subaddress 95 or integrated 106
+
+var validBase58Address = regexp.MustCompile(reBase58Address)
+var validEthereumAddress = regexp.MustCompile(reEthereumAddress)
+var validMoneroAddress = regexp.MustCompile(reMoneroAddress)
+
+// Bitcoin alphabet for base58 encoding excluding 0, O, I and l
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// Bech32 alphabet for segwit addresses per BIP 173
+const bech32Alphabet = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// Bech32 checksum constants for witness version 0 (BIP 173) and versions 1-16 (BIP 350 bech32m)
+const (
+	bech32Const  = 1
+	bech32mConst = 0x2bc830a3
+)
+
+// Base58Check version bytes of mainnet addresses
+var base58Versions = map[byte]CanonicalType{
+	0x00: BitcoinAddress,  // P2PKH starting with 1
+	0x05: BitcoinAddress,  // P2SH starting with 3 (shared with legacy Litecoin P2SH)
+	0x30: LitecoinAddress, // P2PKH starting with L
+	0x32: LitecoinAddress, // P2SH starting with M
+}
+
+// Segwit human readable parts of mainnet addresses
+var bech32Prefixes = map[string]CanonicalType{
+	"bc":  BitcoinAddress,
+	"ltc": LitecoinAddress,
+}
+
+// Monero network bytes of mainnet addresses
+var moneroNetworks = map[byte]int{
+	0x12: 69, // standard address: network, spend key, view key and checksum bytes
+	0x2a: 69, // subaddress
+	0x13: 77, // integrated address additionally containing an 8 byte payment ID
+}
+
+// Monero base58 encoded size of a partial block indexed by its number of bytes
+var moneroBlockSizes = [...]int{0, 2, 3, 5, 6, 7, 9, 10, 11}
+
+// Inspects the string to determine if it is a Bitcoin, Ethereum, Litecoin or Monero wallet address
+// with a valid checksum. Returns Unknown if the string is not a wallet address.
+func inspectWallet(v string) CanonicalType {
+	if validEthereumAddress.MatchString(v) {
+		if isEthereumChecksum(v[2:]) {
+			return EthereumAddress
+		}
+		return Unknown
+	}
+	if validMoneroAddress.MatchString(v) && isMoneroAddress(v) {
+		return MoneroAddress
+	}
+	if validBase58Address.MatchString(v) {
+		if payload, ok := decodeBase58Check(v); ok && len(payload) == 21 {
+			return base58Versions[payload[0]]
+		}
+	}
+	return inspectSegwit(v)
+}
+
+// Decodes a base58 string into bytes, preserving leading zero bytes encoded as leading '1' characters.
+func decodeBase58(v string) ([]byte, bool) {
+	var decoded []byte // big endian base 256 digits
+	for i := 0; i < len(v); i++ {
+		carry := strings.IndexByte(base58Alphabet, v[i])
+		if carry < 0 {
+			return nil, false
+		}
+		for j := len(decoded) - 1; j >= 0; j-- {
+			carry += int(decoded[j]) * 58
+			decoded[j] = byte(carry)
+			carry >>= 8
+		}
+		for carry > 0 {
+			decoded = append([]byte{byte(carry)}, decoded...)
+			carry >>= 8
+		}
+	}
+	for i := 0; i < len(v) && v[i] == '1'; i++ {
+		decoded = append([]byte{0}, decoded...)
+	}
+	return decoded, true
+}
+
+// Decodes a Base58Check string returning the payload (version and data) if the
+// trailing 4 byte double SHA-256 checksum matches.
+func decodeBase58Check(v string) ([]byte, bool) {
+	decoded, ok := decodeBase58(v)
+	if !ok || len(decoded) < 5 {
+		return nil, false
+	}
+	payload := decoded[:len(decoded)-4]
+	first := sha256.Sum256(payload)
+	second := sha256.Sum256(first[:])
+	if !bytes.Equal(second[:4], decoded[len(decoded)-4:]) {
+		return nil, false
+	}
+	return payload, true
+}
+
+// Calculates the bech32 checksum polynomial over the values.
+func bech32Polymod(values []byte) uint32 {
+	generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+	return chk
+}
+
+// Inspects the string to determine if it is a bech32 (witness version 0) or bech32m
+// (witness versions 1-16) segwit address for Bitcoin or Litecoin.
+// Returns Unknown if the string is not a valid segwit address.
+func inspectSegwit(v string) CanonicalType {
+	if len(v) < 14 || len(v) > 90 || (strings.ToLower(v) != v && strings.ToUpper(v) != v) {
+		return Unknown
+	}
+	v = strings.ToLower(v)
+	sep := strings.LastIndexByte(v, '1')
+	if sep < 1 || len(v)-sep-1 < 7 {
+		return Unknown
+	}
+	hrp, data := v[:sep], v[sep+1:]
+	network, ok := bech32Prefixes[hrp]
+	if !ok {
+		return Unknown
+	}
+
+	values := make([]byte, 0, len(hrp)*2+1+len(data))
+	for i := 0; i < len(hrp); i++ {
+		values = append(values, hrp[i]>>5)
+	}
+	values = append(values, 0)
+	for i := 0; i < len(hrp); i++ {
+		values = append(values, hrp[i]&31)
+	}
+	for i := 0; i < len(data); i++ {
+		d := strings.IndexByte(bech32Alphabet, data[i])
+		if d < 0 {
+			return Unknown
+		}
+		values = append(values, byte(d))
+	}
+
+	witness := values[len(values)-len(data):]
+	version := witness[0]
+	checksum := bech32Polymod(values)
+	if (version == 0 && checksum != bech32Const) || (version > 0 && checksum != bech32mConst) || version > 16 {
+		return Unknown
+	}
+
+	// convert 5 bit groups of the witness program (excluding version and checksum) to bytes
+	bitCount := (len(witness) - 7) * 5
+	if bitCount%8 >= 5 {
+		return Unknown
+	}
+	program := bitCount / 8
+	if program < 2 || program > 40 || (version == 0 && program != 20 && program != 32) {
+		return Unknown
+	}
+	return network
+}
+
+// Calculates the legacy Keccak-256 hash used by Ethereum and Monero, which differs from the standardized SHA3-256
+// only by its padding byte.
+func keccak256(data []byte) []byte {
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(data)
+	return hash.Sum(nil)
+}
+
+// Verifies an Ethereum address in hex (without 0x) against its EIP-55 mixed case checksum.
+// All lowercase or all uppercase addresses carry no checksum and are accepted.
+func isEthereumChecksum(addr string) bool {
+	lower := strings.ToLower(addr)
+	if addr == lower || addr == strings.ToUpper(addr) {
+		return true
+	}
+	sum := keccak256([]byte(lower))
+	hash := hex.EncodeToString(sum)
+	for i := 0; i < len(addr); i++ {
+		c := addr[i]
+		if c >= '0' && c <= '9' {
+			continue
+		}
+		upper := hash[i] >= '8'
+		if upper != (c >= 'A' && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+// Verifies a Monero address decoding its block based base58 encoding and validating the
+// network byte, length and trailing 4 byte Keccak-256 checksum.
+func isMoneroAddress(v string) bool {
+	var decoded []byte
+	for len(v) > 0 {
+		size := 11
+		if len(v) < size {
+			size = len(v)
+		}
+		block, ok := decodeMoneroBlock(v[:size])
+		if !ok {
+			return false
+		}
+		decoded = append(decoded, block...)
+		v = v[size:]
+	}
+
+	if len(decoded) < 5 || moneroNetworks[decoded[0]] != len(decoded) {
+		return false
+	}
+	hash := keccak256(decoded[:len(decoded)-4])
+	return bytes.Equal(hash[:4], decoded[len(decoded)-4:])
+}
+
+// Decodes a single Monero base58 block of up to 11 characters into up to 8 bytes.
+func decodeMoneroBlock(block string) ([]byte, bool) {
+	size := -1
+	for i, encoded := range moneroBlockSizes {
+		if encoded == len(block) {
+			size = i
+		}
+	}
+	if size < 1 {
+		return nil, false
+	}
+
+	var num uint64
+	for i := 0; i < len(block); i++ {
+		d := strings.IndexByte(base58Alphabet, block[i])
+		if d < 0 {
+			return nil, false
+		}
+		hi, lo := bits.Mul64(num, 58)
+		if hi != 0 || lo+uint64(d) < lo {
+			return nil, false // overflow
+		}
+		num = lo + uint64(d)
+	}
+	if size < 8 && num >= 1<<(8*uint(size)) {
+		return nil, false
+	}
+
+	out := make([]byte, size)
+	for i := size - 1; i >= 0; i-- {
+		out[i] = byte(num)
+		num >>= 8
+	}
+	return out, true
+}
@@ -0,0 +1,66 @@
+package inspectdata
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestKeccak256(t *testing.T) {
+	sum := keccak256([]byte(""))
+	if h := hex.EncodeToString(sum); h != "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470" {
+		t.Errorf("keccak256 of empty string unexpected hash %s", h)
+	}
+	sum = keccak256([]byte("The quick brown fox jumps over the lazy dog"))
+	if h := hex.EncodeToString(sum); h != "4d741b6f1eb29cb2a9b9911c82f56fa8d73b04959d3d9d222895df6c0b28aa15" {
+		t.Errorf("keccak256 unexpected hash %s", h)
+	}
+}
+
+func TestInspectWallet(t *testing.T) {
+	wallets := map[string]CanonicalType{
+		"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa":                                                              BitcoinAddress,
+		"3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy":                                                              BitcoinAddress,
+		"bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq":                                                      BitcoinAddress,
+		"BC1QAR0SRRR7XFKVY5L643LYDNW9RE59GTZZWF5MDQ":                                                      BitcoinAddress,
+		"bc1p5d7rjq7g6rdk2yhzks9smlaqtedr4dekq08ge8ztwac72sfr9rusxg3297":                                  BitcoinAddress,
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed":                                                      EthereumAddress,
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359":                                                      EthereumAddress,
+		"0x52908400098527886e0f7030069857d2e4169ee7":                                                      EthereumAddress,
+		"LVg2kJoFNg45Nbpy53h7Fe1wKyeXVRhMH9":                                                              LitecoinAddress,
+		"44AFFq5kSiGBoZ4NMDwYtN18obc8AemS33DBLWs3H7otXft3XjrpDtQGv7SqSsaBYBb98uNbr2VBBEt7f2wfn3RVGQBEP3A": MoneroAddress,
+	}
+	for v, expected := range wallets {
+		if c := inspectWallet(v); c != expected {
+			t.Errorf("inspectWallet should have detected %v for %s, but got: %v", expected, v, c)
+		}
+	}
+}
+
+func TestInspectWalletInvalid(t *testing.T) {
+	invalid := []string{
+		"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNb",         // bad Base58Check checksum
+		"bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdx", // bad bech32 checksum
+		"bc1qar0srrr7xfkvy5l643lYdnw9re59gtzzwf5mdq", // mixed case bech32
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", // bad EIP-55 checksum
+		"44AFFq5kSiGBoZ4NMDwYtN18obc8AemS33DBLWs3H7otXft3XjrpDtQGv7SqSsaBYBb98uNbr2VBBEt7f2wfn3RVGQBEP3B",
+		"}++zZYMUptu`IIpeoQ-n",
+	}
+	for _, v := range invalid {
+		if c := inspectWallet(v); c != Unknown {
+			t.Errorf("inspectWallet should not have detected a wallet address for %s, but got: %v", v, c)
+		}
+	}
+}
+
+func TestInspectWalletDatum(t *testing.T) {
+	datum, err := Inspect("1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa")
+	if err != nil {
+		t.Error(err)
+	}
+	if datum.Canonical != BitcoinAddress {
+		t.Errorf("Inspect should have detected canonical type BitcoinAddress, but got: %v", datum.Canonical)
+	}
+	if !datum.IsFinancial || datum.Entropy != 0 {
+		t.Errorf("Wallet address should be denoted as financial rather than secret")
+	}
+}